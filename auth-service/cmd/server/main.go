@@ -10,14 +10,22 @@ import (
 	"syscall"
 
 	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
 	"auth-service/internal/application/usecase"
 	grpcHandler "auth-service/internal/delivery/grpc/handler"
 	"auth-service/internal/delivery/grpc/interceptor"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+	"auth-service/internal/infrastructure/client"
 	"auth-service/internal/infrastructure/config"
 	"auth-service/internal/infrastructure/logger"
+	"auth-service/internal/infrastructure/mail"
 	"auth-service/internal/infrastructure/persistence/postgres"
+	"auth-service/internal/infrastructure/revocation"
+	"auth-service/internal/infrastructure/scheduler"
 	"auth-service/internal/infrastructure/security"
 	"auth-service/internal/infrastructure/telemetry"
+	"auth-service/internal/infrastructure/throttle"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -49,21 +57,120 @@ func main() {
 
 	userRepo := postgres.NewUserRepository(db)
 	refreshTokenRepo := postgres.NewRefreshTokenRepository(db)
-	tokenBlacklistRepo := postgres.NewTokenBlacklistRepository(db)
+	revokedTokens := revocation.NewCache(cfg.Revocation.CacheSize)
+	tokenBlacklistRepo := revocation.NewBlacklistRepository(postgres.NewTokenBlacklistRepository(db), revokedTokens)
 	auditLogRepo := postgres.NewAuditLogRepository(db)
+	verificationTokenRepo := postgres.NewVerificationTokenRepository(db)
+	recoveryCodeRepo := postgres.NewRecoveryCodeRepository(db)
+	oauthClientRepo := postgres.NewOAuthClientRepository(db)
+	authorizationCodeRepo := postgres.NewOAuthAuthorizationCodeRepository(db)
+	oauthConsentRepo := postgres.NewOAuthConsentRepository(db)
+	apiKeyRepo := postgres.NewAPIKeyRepository(db)
+	passwordHistoryRepo := postgres.NewPasswordHistoryRepository(db)
+	knownDeviceRepo := postgres.NewKnownDeviceRepository(db)
+	accountDeletionRepo := postgres.NewAccountDeletionRepository(db)
+	dataExportRepo := postgres.NewDataExportRepository(db)
+	emailChangeRepo := postgres.NewEmailChangeRepository(db)
 
-	passwordService := security.NewBcryptPasswordService()
-	tokenService, err := security.NewJWTService(
+	signingKeyRepo := postgres.NewSigningKeyRepository(db)
+
+	secretCipher, err := security.NewAESCipher(cfg.Security.EncryptionKey)
+	if err != nil {
+		log.Error("failed to initialize secret cipher", zap.Error(err))
+		panic(err)
+	}
+
+	passwordService := security.NewArgon2idPasswordService(security.Argon2Params{
+		Memory:      uint32(cfg.Password.Argon2Memory),
+		Iterations:  uint32(cfg.Password.Argon2Iterations),
+		Parallelism: uint8(cfg.Password.Argon2Parallelism),
+	})
+
+	var breachedPasswords *security.BloomFilter
+	if cfg.Password.BreachedCheck {
+		breachedPasswords, err = security.LoadBreachedPasswords(cfg.Password.BreachedListPath, cfg.Password.BreachedFalsePositiveRate)
+		if err != nil {
+			log.Error("failed to load breached password list", zap.Error(err))
+			panic(err)
+		}
+	}
+	passwordPolicy := security.NewPasswordPolicy(security.PasswordPolicyRules{
+		MinLength:       cfg.Password.MinLength,
+		MaxLength:       cfg.Password.MaxLength,
+		RequiredClasses: cfg.Password.CharacterClasses,
+		MaxRepeats:      cfg.Password.MaxRepeats,
+		DisallowEmail:   cfg.Password.DisallowEmail,
+	}, breachedPasswords)
+	keyRing := security.NewKeyRing(
+		signingKeyRepo,
+		secretCipher,
 		cfg.JWT.Algorithm,
-		cfg.JWT.PrivateKeyPath,
-		cfg.JWT.PublicKeyPath,
-		cfg.JWT.AccessTokenTTL,
-		cfg.JWT.RefreshTokenTTL,
+		cfg.JWT.AccessTokenTTL+cfg.JWT.KeyReloadInterval,
 	)
-	if err != nil {
-		log.Error("failed to initialize JWT service", zap.Error(err))
+	if err := keyRing.Bootstrap(context.Background(), cfg.JWT.PrivateKeyPath, cfg.JWT.PublicKeyPath); err != nil {
+		log.Error("failed to load signing keys", zap.Error(err))
+		panic(err)
+	}
+	tokenService := security.NewJWTService(keyRing, cfg.JWT.AccessTokenTTL, cfg.JWT.RefreshTokenTTL, cfg.OAuth.Issuer)
+
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go keyRing.Run(backgroundCtx, cfg.JWT.KeyReloadInterval, cfg.JWT.KeyRotationInterval, log.Logger)
+
+	// Other replicas revoke tokens too; poll the table so this one sees them.
+	revocationSyncer := revocation.NewSyncer(revokedTokens, revocation.NewRepositoryFeed(tokenBlacklistRepo), cfg.Revocation.SyncOverlap)
+	if err := revocationSyncer.Sync(context.Background()); err != nil {
+		log.Error("failed to load revoked tokens", zap.Error(err))
 		panic(err)
 	}
+	go revocationSyncer.Run(backgroundCtx, cfg.Revocation.SyncInterval, log.Logger)
+
+	totpService := security.NewTOTPService(cfg.MFA.Issuer)
+
+	var throttleRepo repository.ThrottleRepository
+	var memoryThrottleStore *throttle.MemoryStore
+	if cfg.Throttle.Store == "memory" {
+		memoryThrottleStore = throttle.NewMemoryStore()
+		throttleRepo = memoryThrottleStore
+	} else {
+		throttleRepo = postgres.NewThrottleRepository(db)
+	}
+	// The global limits only block; delaying every login would not help.
+	throttler := throttle.NewThrottler(throttleRepo, throttle.Limits{
+		LoginPerIP: throttle.Limit{
+			Window: cfg.Throttle.LoginIPWindow, Free: cfg.Throttle.LoginIPFree, Max: cfg.Throttle.LoginIPMax,
+			BaseDelay: cfg.Throttle.BaseDelay, MaxDelay: cfg.Throttle.MaxDelay,
+		},
+		LoginPerEmail: throttle.Limit{
+			Window: cfg.Throttle.LoginEmailWindow, Free: cfg.Throttle.LoginEmailFree, Max: cfg.Throttle.LoginEmailMax,
+			BaseDelay: cfg.Throttle.BaseDelay, MaxDelay: cfg.Throttle.MaxDelay,
+		},
+		LoginGlobal: throttle.Limit{
+			Window: cfg.Throttle.LoginGlobalWindow, Free: cfg.Throttle.LoginGlobalMax, Max: cfg.Throttle.LoginGlobalMax,
+		},
+		RegisterPerIP: throttle.Limit{
+			Window: cfg.Throttle.RegisterIPWindow, Free: cfg.Throttle.RegisterIPFree, Max: cfg.Throttle.RegisterIPMax,
+			BaseDelay: cfg.Throttle.BaseDelay, MaxDelay: cfg.Throttle.MaxDelay,
+		},
+		RegisterGlobal: throttle.Limit{
+			Window: cfg.Throttle.RegisterGlobalWindow, Free: cfg.Throttle.RegisterGlobalMax, Max: cfg.Throttle.RegisterGlobalMax,
+		},
+	})
+	if memoryThrottleStore != nil && cfg.Scheduler.ThrottleEventInterval > 0 {
+		go memoryThrottleStore.Run(backgroundCtx, cfg.Scheduler.ThrottleEventInterval, throttler.Retention(), log.Logger)
+	}
+
+	var mailSender service.MailSender
+	switch cfg.Mail.Driver {
+	case "file":
+		mailSender, err = mail.NewFileSender(cfg.Mail.From, cfg.Mail.OutboxDir)
+		if err != nil {
+			log.Error("failed to initialize mail sender", zap.Error(err))
+			panic(err)
+		}
+	default:
+		mailSender = mail.NewLogSender(log.Logger)
+	}
 
 	// --- Telemetry Initialization ---
 	shutdownTelemetry, err := telemetry.Init("auth-service", cfg.Telemetry.CollectorAddr)
@@ -93,20 +200,224 @@ func main() {
 		refreshTokenRepo,
 		tokenBlacklistRepo,
 		auditLogRepo,
+		verificationTokenRepo,
+		recoveryCodeRepo,
+		oauthClientRepo,
+		authorizationCodeRepo,
+		oauthConsentRepo,
+		apiKeyRepo,
+		passwordHistoryRepo,
+		knownDeviceRepo,
+		accountDeletionRepo,
+		dataExportRepo,
+		emailChangeRepo,
 		passwordService,
+		passwordPolicy,
 		tokenService,
+		keyRing,
+		mailSender,
+		totpService,
+		secretCipher,
 		usecase.AuthConfig{
-			MaxLoginAttempts:    cfg.Security.MaxLoginAttempts,
-			AccountLockDuration: cfg.Security.AccountLockDuration,
+			MaxLoginAttempts:         cfg.Security.MaxLoginAttempts,
+			AccountLockDuration:      cfg.Security.AccountLockDuration,
+			RequireEmailVerification: cfg.Security.RequireEmailVerification,
+			VerificationTokenTTL:     cfg.Security.VerificationTokenTTL,
+			PasswordResetTokenTTL:    cfg.Security.PasswordResetTokenTTL,
+			MFAChallengeTTL:          cfg.MFA.ChallengeTTL,
+			MFARequiredForAdmins:     cfg.MFA.RequiredForAdmins,
+			RefreshReuseGracePeriod:  cfg.Security.RefreshReuseGracePeriod,
+			AppBaseURL:               cfg.Mail.AppBaseURL,
+			OIDCIssuer:               cfg.OAuth.Issuer,
+			OAuthAuthorizeURL:        cfg.OAuth.AuthorizeURL,
+			AuthorizationCodeTTL:     cfg.OAuth.AuthorizationCodeTTL,
+			ServiceTokenTTL:          cfg.OAuth.ServiceTokenTTL,
+			APIKeyDefaultTTL:         cfg.APIKey.DefaultTTL,
+			APIKeyMaxTTL:             cfg.APIKey.MaxTTL,
+			PasswordHistorySize:      cfg.Password.HistorySize,
+			PasswordMaxAge:           cfg.Password.MaxAge,
+			PasswordChangeTokenTTL:   cfg.Password.ChangeTokenTTL,
+			UnrecognizedLoginLinkTTL: cfg.Device.UnrecognizedLoginLinkTTL,
+			LoginStepUp:              cfg.Device.StepUp,
+			LoginStepUpTTL:           cfg.Device.StepUpTTL,
+			EmailChangeTokenTTL:      cfg.EmailChange.TokenTTL,
 		},
 	)
 
+	for _, seed := range cfg.OAuth.MachineClients {
+		if err := authUseCase.ProvisionMachineClient(context.Background(), dto.ProvisionMachineClientRequest{
+			Name:     seed.Name,
+			ClientID: seed.ClientID,
+			Secret:   seed.Secret,
+			Scopes:   seed.Scopes,
+		}); err != nil {
+			log.Error("failed to provision machine client", zap.String("client", seed.Name), zap.Error(err))
+			panic(err)
+		}
+	}
+
+	maintenanceUseCase := usecase.NewMaintenanceUseCase(
+		refreshTokenRepo,
+		tokenBlacklistRepo,
+		verificationTokenRepo,
+		auditLogRepo,
+		authorizationCodeRepo,
+		throttleRepo,
+		knownDeviceRepo,
+		dataExportRepo,
+		emailChangeRepo,
+		usecase.MaintenanceConfig{
+			RefreshTokenRetention:      cfg.Scheduler.RefreshTokenRetention,
+			TokenBlacklistRetention:    cfg.Scheduler.TokenBlacklistRetention,
+			VerificationTokenRetention: cfg.Scheduler.VerificationTokenRetention,
+			AuditLogRetentionDays:      cfg.Scheduler.AuditLogRetentionDays,
+			ThrottleEventRetention:     throttler.Retention(),
+			KnownDeviceRetention:       cfg.Scheduler.KnownDeviceRetention,
+		},
+	)
+
+	// Deleted accounts are erased, data exports collected and changed emails
+	// synced in the other services by scheduled jobs, which call them with
+	// tokens the auth-service signs for itself.
+	userClient, err := client.NewUserClient(cfg.Services.UserServiceAddr, tokenService, cfg.OAuth.ServiceTokenTTL)
+	if err != nil {
+		log.Error("failed to create user-service client", zap.Error(err))
+		panic(err)
+	}
+	defer userClient.Close()
+	orderClient, err := client.NewOrderClient(cfg.Services.OrderServiceAddr, tokenService, cfg.OAuth.ServiceTokenTTL)
+	if err != nil {
+		log.Error("failed to create order-service client", zap.Error(err))
+		panic(err)
+	}
+	defer orderClient.Close()
+
+	accountDeletionUseCase := usecase.NewAccountDeletionUseCase(
+		accountDeletionRepo,
+		auditLogRepo,
+		userClient,
+		orderClient,
+		usecase.AccountDeletionConfig{
+			BatchSize:      cfg.Deletion.BatchSize,
+			CallTimeout:    cfg.Deletion.CallTimeout,
+			RetryBaseDelay: cfg.Deletion.RetryBaseDelay,
+			RetryMaxDelay:  cfg.Deletion.RetryMaxDelay,
+		},
+	)
+
+	dataExportUseCase := usecase.NewDataExportUseCase(
+		userRepo,
+		auditLogRepo,
+		dataExportRepo,
+		userClient,
+		orderClient,
+		usecase.DataExportConfig{
+			BatchSize:   cfg.Export.BatchSize,
+			CallTimeout: cfg.Export.CallTimeout,
+			TTL:         cfg.Export.TTL,
+		},
+	)
+
+	emailSyncUseCase := usecase.NewEmailSyncUseCase(
+		userRepo,
+		emailChangeRepo,
+		userClient,
+		usecase.EmailSyncConfig{
+			BatchSize:      cfg.EmailChange.BatchSize,
+			CallTimeout:    cfg.EmailChange.CallTimeout,
+			RetryBaseDelay: cfg.EmailChange.RetryBaseDelay,
+			RetryMaxDelay:  cfg.EmailChange.RetryMaxDelay,
+		},
+	)
+
+	// --- Scheduler ---
+	jobScheduler := scheduler.New(postgres.NewAdvisoryLock(db), log.Logger)
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_refresh_tokens",
+		Interval: cfg.Scheduler.RefreshTokenInterval,
+		Run:      maintenanceUseCase.PurgeExpiredRefreshTokens,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_token_blacklist",
+		Interval: cfg.Scheduler.TokenBlacklistInterval,
+		Run:      maintenanceUseCase.PurgeExpiredBlacklistEntries,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_verification_tokens",
+		Interval: cfg.Scheduler.VerificationTokenInterval,
+		Run:      maintenanceUseCase.PurgeExpiredVerificationTokens,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_audit_logs",
+		Interval: cfg.Scheduler.AuditLogInterval,
+		Run:      maintenanceUseCase.PurgeOldAuditLogs,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_oauth_authorization_codes",
+		Interval: cfg.Scheduler.AuthorizationCodeInterval,
+		Run:      maintenanceUseCase.PurgeExpiredAuthorizationCodes,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_known_devices",
+		Interval: cfg.Scheduler.KnownDeviceInterval,
+		Run:      maintenanceUseCase.PurgeStaleKnownDevices,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "process_account_deletions",
+		Interval: cfg.Scheduler.AccountDeletionInterval,
+		Run:      accountDeletionUseCase.ProcessDueDeletions,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "process_data_exports",
+		Interval: cfg.Scheduler.DataExportInterval,
+		Run:      dataExportUseCase.ProcessPendingExports,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_data_exports",
+		Interval: cfg.Scheduler.DataExportPurgeInterval,
+		Run:      maintenanceUseCase.PurgeExpiredDataExports,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "sync_email_changes",
+		Interval: cfg.Scheduler.EmailSyncInterval,
+		Run:      emailSyncUseCase.ProcessUnsyncedChanges,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "purge_email_changes",
+		Interval: cfg.Scheduler.EmailChangeInterval,
+		Run:      maintenanceUseCase.PurgeFinishedEmailChanges,
+	})
+	// The in-memory throttle store is purged by every replica itself.
+	if memoryThrottleStore == nil {
+		jobScheduler.Register(scheduler.Job{
+			Name:     "purge_throttle_events",
+			Interval: cfg.Scheduler.ThrottleEventInterval,
+			Run:      maintenanceUseCase.PurgeOldThrottleEvents,
+		})
+	}
+
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	schedulerDone := make(chan struct{})
+	go func() {
+		jobScheduler.Run(schedulerCtx)
+		close(schedulerDone)
+	}()
+
 	grpcHandler := grpcHandler.NewGRPCHandler(*authUseCase)
 
 	tokenValidator := interceptor.NewTokenServiceAdapter(tokenService)
+	trustedProxies, err := interceptor.ParseTrustedProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Error("invalid trusted proxies", zap.Error(err))
+		panic(err)
+	}
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()), // OpenTelemetry StatsHandler
-		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor(tokenValidator)),
+		grpc.ChainUnaryInterceptor(
+			interceptor.NewAuthInterceptor(tokenValidator, revokedTokens, trustedProxies),
+			interceptor.NewThrottleInterceptor(throttler, trustedProxies),
+		),
+		grpc.StreamInterceptor(interceptor.NewAuthStreamInterceptor(tokenValidator, revokedTokens, trustedProxies)),
 	)
 	proto.RegisterAuthServiceServer(grpcServer, grpcHandler)
 
@@ -146,5 +457,13 @@ func main() {
 		log.Warn("gRPC server forced to shutdown")
 	}
 
+	// Stop the scheduler so its leader locks are released for other replicas.
+	stopScheduler()
+	select {
+	case <-schedulerDone:
+	case <-ctx.Done():
+		log.Warn("scheduler did not stop in time")
+	}
+
 	log.Info("server stopped")
 }
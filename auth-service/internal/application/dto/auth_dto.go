@@ -21,9 +21,67 @@ type ChangePasswordRequest struct {
 	NewPassword string `json:"new_password" binding:"required,min=8,max=128"`
 }
 
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8,max=128"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type ChangeExpiredPasswordRequest struct {
+	PasswordChangeToken string `json:"password_change_token" binding:"required"`
+	NewPassword         string `json:"new_password" binding:"required"`
+}
+
+type VerifyLoginStepUpRequest struct {
+	StepUpToken string `json:"step_up_token" binding:"required"`
+	Code        string `json:"code" binding:"required"`
+}
+
+type ReportUnrecognizedLoginRequest struct {
+	Token     string `json:"token" binding:"required"`
+	SessionID string `json:"session_id" binding:"required"`
+}
+
+type DisableMFARequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
 type AuthResponse struct {
-	AccessToken  string `json:"access_token"`
+	AccessToken  string `json:"access_token,omitempty"`
 	RefreshToken string `json:"refresh_token,omitempty"`
+	MFARequired  bool   `json:"mfa_required,omitempty"`
+	MFAToken     string `json:"mfa_token,omitempty"`
+	// PasswordExpired means no tokens were issued: the password must be
+	// changed with PasswordChangeToken first.
+	PasswordExpired     bool   `json:"password_expired,omitempty"`
+	PasswordChangeToken string `json:"password_change_token,omitempty"`
+	// StepUpRequired means no tokens were issued: the login came from an
+	// unknown device and network, and the code emailed to the user must be
+	// sent with StepUpToken.
+	StepUpRequired bool   `json:"step_up_required,omitempty"`
+	StepUpToken    string `json:"step_up_token,omitempty"`
+}
+
+type MFAEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
 }
 
 type RefreshTokenResponse struct {
@@ -37,9 +95,275 @@ type UserDTO struct {
 	Role       string    `json:"role"`
 	IsVerified bool      `json:"is_verified"`
 	IsActive   bool      `json:"is_active"`
+	MFAEnabled bool      `json:"mfa_enabled"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SessionDTO struct {
+	ID         string    `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
 	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"`
+}
+
+type AuditLogDTO struct {
+	ID        string                 `json:"id"`
+	UserID    string                 `json:"user_id"`
+	Action    string                 `json:"action"`
+	IPAddress string                 `json:"ip_address"`
+	UserAgent string                 `json:"user_agent"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+type SearchAuditLogsRequest struct {
+	UserID    string   `json:"user_id"`
+	Actions   []string `json:"actions"`
+	IPAddress string   `json:"ip_address"`
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	PageSize  int      `json:"page_size"`
+	PageToken string   `json:"page_token"`
+}
+
+type AuditLogPage struct {
+	Logs          []*AuditLogDTO `json:"logs"`
+	NextPageToken string         `json:"next_page_token,omitempty"`
+}
+
+// AdminUserDTO is the view of an account that admins get, including its
+// lockout state.
+type AdminUserDTO struct {
+	ID                    string     `json:"id"`
+	Email                 string     `json:"email"`
+	Role                  string     `json:"role"`
+	IsVerified            bool       `json:"is_verified"`
+	IsActive              bool       `json:"is_active"`
+	MFAEnabled            bool       `json:"mfa_enabled"`
+	IsLocked              bool       `json:"is_locked"`
+	LockedUntil           *time.Time `json:"locked_until,omitempty"`
+	FailedLoginAttempts   int        `json:"failed_login_attempts"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	LastLoginAt           *time.Time `json:"last_login_at,omitempty"`
+	LastLoginIP           string     `json:"last_login_ip,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+}
+
+type ListUsersRequest struct {
+	Query    string `json:"query"`
+	Role     string `json:"role"`
+	IsActive *bool  `json:"is_active"`
+	Locked   *bool  `json:"locked"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+type UserPage struct {
+	Users    []*AdminUserDTO `json:"users"`
+	Total    int64           `json:"total"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"page_size"`
+}
+
+type RevokedTokenDTO struct {
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type RevokedTokenPage struct {
+	Tokens    []*RevokedTokenDTO `json:"tokens"`
+	NextSince time.Time          `json:"next_since"`
+	HasMore   bool               `json:"has_more"`
+}
+
+// TokenIntrospection follows RFC 7662: when Active is false every other
+// field is empty.
+type TokenIntrospection struct {
+	Active    bool   `json:"active"`
+	Subject   string `json:"sub,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Role      string `json:"role,omitempty"`
+	SessionID string `json:"sid,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+}
+
+// AuthorizeRequest carries the parameters of an OAuth authorization request
+// (RFC 6749 section 4.1.1 with PKCE).
+type AuthorizeRequest struct {
+	ResponseType        string `json:"response_type"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Nonce               string `json:"nonce"`
+}
+
+// AuthorizeResponse either asks the login app to show the consent screen or
+// tells it where to send the browser next.
+type AuthorizeResponse struct {
+	RedirectTo      string   `json:"redirect_to,omitempty"`
+	ConsentRequired bool     `json:"consent_required,omitempty"`
+	ClientName      string   `json:"client_name,omitempty"`
+	Scopes          []string `json:"scopes,omitempty"`
+}
+
+type OAuthTokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	Code         string `json:"code"`
+	RedirectURI  string `json:"redirect_uri"`
+	CodeVerifier string `json:"code_verifier"`
+	RefreshToken string `json:"refresh_token"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Scope        string `json:"scope"`
+}
+
+// OAuthTokenResponse has no refresh token for the client_credentials grant.
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+type CreateOAuthClientRequest struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	Confidential bool     `json:"confidential"`
+	FirstParty   bool     `json:"first_party"`
+	Machine      bool     `json:"machine"`
+}
+
+// ProvisionMachineClientRequest registers a machine client with a client ID
+// and secret chosen by the operator rather than generated.
+type ProvisionMachineClientRequest struct {
+	Name     string
+	ClientID string
+	Secret   string
+	Scopes   []string
+}
+
+type OAuthClientDTO struct {
+	ClientID     string    `json:"client_id"`
+	Name         string    `json:"name"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	Scopes       []string  `json:"scopes"`
+	Confidential bool      `json:"confidential"`
+	FirstParty   bool      `json:"first_party"`
+	Machine      bool      `json:"machine"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CreatedOAuthClient holds the plain client secret, which is only shown
+// once; it is empty for public clients.
+type CreatedOAuthClient struct {
+	Client       *OAuthClientDTO `json:"client"`
+	ClientSecret string          `json:"client_secret,omitempty"`
+}
+
+type OAuthConsentDTO struct {
+	ClientID   string    `json:"client_id"`
+	ClientName string    `json:"client_name"`
+	Scopes     []string  `json:"scopes"`
+	GrantedAt  time.Time `json:"granted_at"`
+}
+
+// OpenIDConfiguration is the OpenID Connect discovery document.
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
 }
 
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int32    `json:"expires_in_days"`
+}
+
+type APIKeyDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedAPIKey holds the plain key, which is only shown once.
+type CreatedAPIKey struct {
+	APIKey *APIKeyDTO `json:"api_key"`
+	Key    string     `json:"key"`
+}
+
+// APIKeyIntrospection is the auth-service's answer for one API key. When
+// Active is false the other fields are empty.
+type APIKeyIntrospection struct {
+	Active  bool     `json:"active"`
+	KeyID   string   `json:"key_id,omitempty"`
+	Subject string   `json:"sub,omitempty"`
+	Email   string   `json:"email,omitempty"`
+	Role    string   `json:"role,omitempty"`
+	Scopes  []string `json:"scopes,omitempty"`
+}
+
+// DeleteAccountRequest re-authenticates the user; Code is a TOTP or recovery
+// code and is only needed with MFA enabled.
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code"`
+}
+
+// DataExportDTO is the status of a personal data export. The archive can be
+// downloaded while Status is "ready", until ExpiresAt.
+type DataExportDTO struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	SizeBytes   int64      `json:"size_bytes,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// DataExportArchive is a downloaded export: a ZIP of JSON files.
+type DataExportArchive struct {
+	FileName string `json:"file_name"`
+	Content  []byte `json:"content"`
+}
+
+// RequestEmailChangeRequest re-authenticates the user like
+// DeleteAccountRequest; the change takes effect once the link sent to
+// NewEmail is opened.
+type RequestEmailChangeRequest struct {
+	NewEmail string `json:"new_email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+)
+
+// DeleteAccount deletes the caller's account once they have confirmed it with
+// their password and, with MFA enabled, a TOTP or recovery code. The
+// account's data in the auth-service is erased at once and every session
+// ends; the profile and orders are handled afterwards by
+// AccountDeletionUseCase.
+func (uc *AuthUseCase) DeleteAccount(ctx context.Context, userID string, req dto.DeleteAccountRequest, accessToken, ipAddress, userAgent string) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.passwordService.VerifyPassword(user.PasswordHash, req.Password); err != nil {
+		return domainErr.ErrInvalidPassword
+	}
+	if user.MFAEnabled {
+		if _, err := uc.verifySecondFactor(ctx, user, req.Code); err != nil {
+			return err
+		}
+	}
+
+	// Refresh tokens are gone with the account, and every access token of
+	// the user is revoked with it through the revocation feed, so services
+	// reject them even with introspection turned off. The caller's own token
+	// is also blacklisted here, so this replica rejects it before its next
+	// sync of the feed.
+	deletion := entity.NewAccountDeletion(user.ID)
+	revocation := entity.NewUserRevocation(user.ID, time.Now().Add(uc.tokenService.GetAccessTokenExpiry()))
+	user.Erase()
+	if err := uc.accountDeletionRepo.Start(ctx, user, deletion, revocation); err != nil {
+		return err
+	}
+
+	if accessToken != "" {
+		blacklist := entity.NewTokenBlacklist(uc.tokenService.HashToken(accessToken), time.Now().Add(uc.tokenService.GetAccessTokenExpiry()))
+		_ = uc.tokenBlacklistRepo.Add(ctx, blacklist)
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionAccountDeleted, ipAddress, userAgent)
+	auditLog.AddMetadata("account_deletion_id", deletion.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// AccountDeletionUseCase erases the data of deleted accounts held by the
+// other services. It is run periodically by the scheduler, and keeps calling
+// each service until it confirms; both calls are idempotent, so a call that
+// succeeded without being recorded is simply repeated.
+type AccountDeletionUseCase struct {
+	accountDeletionRepo repository.AccountDeletionRepository
+	auditLogRepo        repository.AuditLogRepository
+	profileEraser       service.ProfileEraser
+	orderAnonymizer     service.OrderAnonymizer
+	config              AccountDeletionConfig
+}
+
+// AccountDeletionConfig sets how many deletions one run handles, how long a
+// call to another service may take, and the delay after a failed attempt,
+// from RetryBaseDelay doubling up to RetryMaxDelay.
+type AccountDeletionConfig struct {
+	BatchSize      int
+	CallTimeout    time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+func NewAccountDeletionUseCase(
+	accountDeletionRepo repository.AccountDeletionRepository,
+	auditLogRepo repository.AuditLogRepository,
+	profileEraser service.ProfileEraser,
+	orderAnonymizer service.OrderAnonymizer,
+	config AccountDeletionConfig,
+) *AccountDeletionUseCase {
+	return &AccountDeletionUseCase{
+		accountDeletionRepo: accountDeletionRepo,
+		auditLogRepo:        auditLogRepo,
+		profileEraser:       profileEraser,
+		orderAnonymizer:     orderAnonymizer,
+		config:              config,
+	}
+}
+
+// ProcessDueDeletions attempts the deletions that are due and returns how
+// many of them completed.
+func (uc *AccountDeletionUseCase) ProcessDueDeletions(ctx context.Context) (int64, error) {
+	deletions, err := uc.accountDeletionRepo.ListDue(ctx, time.Now(), uc.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var completed int64
+	for _, deletion := range deletions {
+		uc.attempt(ctx, deletion)
+		if err := uc.accountDeletionRepo.Update(ctx, deletion); err != nil {
+			return completed, err
+		}
+		if deletion.IsCompleted() {
+			completed++
+			auditLog := entity.NewAuditLog(deletion.UserID, entity.AuditActionAccountDeletionCompleted, "", "")
+			auditLog.AddMetadata("account_deletion_id", deletion.ID.String())
+			_ = uc.auditLogRepo.Create(ctx, auditLog)
+		}
+	}
+	return completed, nil
+}
+
+// attempt calls every service that has not confirmed yet and records the
+// outcome on deletion.
+func (uc *AccountDeletionUseCase) attempt(ctx context.Context, deletion *entity.AccountDeletion) {
+	userID := deletion.UserID.String()
+	var failures []string
+
+	if deletion.ProfileDeletedAt == nil {
+		if err := uc.call(ctx, func(ctx context.Context) error {
+			return uc.profileEraser.DeleteUserProfile(ctx, userID)
+		}); err != nil {
+			failures = append(failures, "user-service: "+err.Error())
+		} else {
+			deletion.MarkProfileDeleted()
+		}
+	}
+	if deletion.OrdersAnonymizedAt == nil {
+		if err := uc.call(ctx, func(ctx context.Context) error {
+			return uc.orderAnonymizer.AnonymizeUserOrders(ctx, userID)
+		}); err != nil {
+			failures = append(failures, "order-service: "+err.Error())
+		} else {
+			deletion.MarkOrdersAnonymized()
+		}
+	}
+
+	if len(failures) > 0 {
+		deletion.Fail(strings.Join(failures, "; "), uc.config.RetryBaseDelay, uc.config.RetryMaxDelay)
+	}
+}
+
+func (uc *AccountDeletionUseCase) call(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.config.CallTimeout)
+	defer cancel()
+	return fn(ctx)
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+// startedDeletions records the revocation each deletion started with.
+type startedDeletions struct {
+	repository.AccountDeletionRepository
+
+	revocations []*entity.TokenBlacklist
+}
+
+func (r *startedDeletions) Start(ctx context.Context, user *entity.User, deletion *entity.AccountDeletion, revocation *entity.TokenBlacklist) error {
+	r.revocations = append(r.revocations, revocation)
+	return nil
+}
+
+func TestDeleteAccountRevokesEveryAccessTokenOfTheUser(t *testing.T) {
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	deletions := &startedDeletions{}
+	blacklist := &memoryBlacklist{}
+	uc := &AuthUseCase{
+		userRepo:            newMemoryUsers(user),
+		accountDeletionRepo: deletions,
+		tokenBlacklistRepo:  blacklist,
+		auditLogRepo:        discardAuditLogs{},
+		tokenService:        hashingTokens{},
+		passwordService:     plainPasswords{},
+	}
+
+	before := time.Now()
+	require.NoError(t, uc.DeleteAccount(context.Background(), user.ID.String(), dto.DeleteAccountRequest{Password: "Tangerine-Orbit-42"}, "caller-token", "", ""))
+
+	// The user's other sessions have access tokens this request never saw.
+	require.Len(t, deletions.revocations, 1)
+	revocation := deletions.revocations[0]
+	require.Equal(t, entity.UserRevocationKey(user.ID), revocation.TokenHash)
+	require.False(t, revocation.ExpiresAt.Before(before.Add(15*time.Minute)))
+
+	require.Equal(t, []string{hashingTokens{}.HashToken("caller-token")}, blacklist.hashes)
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+)
+
+// requireAdmin loads the acting user and checks their role against the
+// database rather than trusting the role claim in the access token.
+func (uc *AuthUseCase) requireAdmin(ctx context.Context, actorID string) (*entity.User, error) {
+	actor, err := uc.findUser(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsActive || uc.effectiveRole(actor) != entity.RoleAdmin {
+		return nil, domainErr.ErrForbidden
+	}
+	return actor, nil
+}
+
+// effectiveRole is the role user's tokens carry. With MFARequiredForAdmins,
+// an admin without MFA acts as a plain user: they can still sign in and
+// enroll, but get no admin access until MFA is enabled.
+func (uc *AuthUseCase) effectiveRole(user *entity.User) entity.Role {
+	if user.IsAdmin() && uc.config.MFARequiredForAdmins && !user.MFAEnabled {
+		return entity.RoleUser
+	}
+	return user.Role
+}
+
+func (uc *AuthUseCase) RotateSigningKeys(ctx context.Context, actorID, ipAddress, userAgent string) (string, error) {
+	actor, err := uc.requireAdmin(ctx, actorID)
+	if err != nil {
+		return "", err
+	}
+
+	previousKeyID := uc.tokenService.GetKeyID()
+	if err := uc.keyManager.Rotate(ctx); err != nil {
+		return "", err
+	}
+	keyID := uc.tokenService.GetKeyID()
+
+	auditLog := entity.NewAuditLog(actor.ID, entity.AuditActionSigningKeyRotated, ipAddress, userAgent)
+	auditLog.AddMetadata("previous_key_id", previousKeyID)
+	auditLog.AddMetadata("key_id", keyID)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return keyID, nil
+}
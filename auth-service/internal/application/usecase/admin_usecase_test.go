@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"testing"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminWithoutMFAActsAsUser(t *testing.T) {
+	uc := &AuthUseCase{config: AuthConfig{MFARequiredForAdmins: true}}
+	admin := &entity.User{Role: entity.RoleAdmin}
+
+	require.Equal(t, entity.RoleUser, uc.effectiveRole(admin))
+
+	admin.EnableMFA()
+	require.Equal(t, entity.RoleAdmin, uc.effectiveRole(admin))
+}
+
+func TestAdminMFARequirementCanBeTurnedOff(t *testing.T) {
+	uc := &AuthUseCase{config: AuthConfig{}}
+
+	require.Equal(t, entity.RoleAdmin, uc.effectiveRole(&entity.User{Role: entity.RoleAdmin}))
+}
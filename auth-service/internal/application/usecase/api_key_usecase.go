@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// apiKeyPrefix marks personal API keys so they are recognisable, e.g.
+	// by secret scanners; apiKeyDisplayLength characters are kept in clear.
+	apiKeyPrefix        = "ak_"
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+	maxAPIKeyNameLength = 100
+)
+
+// Personal API keys let scripts call the user-facing APIs without a
+// refresh-token loop. A key is stored like a refresh token, as its HashToken
+// hash, and the plain key is shown only once. The other services resolve
+// keys through IntrospectAPIKey and only allow the RPCs the key's scopes
+// cover; the auth-service itself does not accept API keys, so a key cannot
+// manage keys, sessions or the password.
+
+func (uc *AuthUseCase) CreateAPIKey(ctx context.Context, userID string, req dto.CreateAPIKeyRequest, ipAddress, userAgent string) (*dto.CreatedAPIKey, error) {
+	user, err := uc.findAuthorizingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxAPIKeyNameLength {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	// Keys must name their scopes; there is no default.
+	scopes := entity.ParseScope(strings.Join(req.Scopes, " "))
+	if len(scopes) == 0 || !entity.ContainsScopes(entity.APIKeyScopes, scopes) {
+		return nil, domainErr.ErrInvalidScope
+	}
+
+	ttl := uc.config.APIKeyDefaultTTL
+	if req.ExpiresInDays != 0 {
+		ttl = time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	}
+	if ttl <= 0 || ttl > uc.config.APIKeyMaxTTL {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	secret, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+	plain := apiKeyPrefix + secret
+
+	key := entity.NewAPIKey(user.ID, name, plain[:apiKeyDisplayLength], uc.tokenService.HashToken(plain), scopes, time.Now().Add(ttl))
+	if err := uc.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionAPIKeyCreated, ipAddress, userAgent)
+	auditLog.AddMetadata("api_key_id", key.ID.String())
+	auditLog.AddMetadata("scope", entity.FormatScope(scopes))
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return &dto.CreatedAPIKey{
+		APIKey: toAPIKeyDTO(key),
+		Key:    plain,
+	}, nil
+}
+
+// ListAPIKeys returns the user's keys that have not been revoked, including
+// expired ones.
+func (uc *AuthUseCase) ListAPIKeys(ctx context.Context, userID string) ([]*dto.APIKeyDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	keys, err := uc.apiKeyRepo.ListByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.APIKeyDTO, len(keys))
+	for i, key := range keys {
+		result[i] = toAPIKeyDTO(key)
+	}
+	return result, nil
+}
+
+func (uc *AuthUseCase) RevokeAPIKey(ctx context.Context, userID, keyID, ipAddress, userAgent string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+	keyUUID, err := uuid.Parse(keyID)
+	if err != nil {
+		return domainErr.ErrAPIKeyNotFound
+	}
+
+	if err := uc.apiKeyRepo.Revoke(ctx, keyUUID, userUUID); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionAPIKeyRevoked, ipAddress, userAgent)
+	auditLog.AddMetadata("api_key_id", keyUUID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// IntrospectAPIKey resolves an API key to its owner and scopes for the other
+// services, and records the use. Like IntrospectToken, unusable keys are
+// reported as inactive without a reason: unknown, revoked or expired keys,
+// and keys of users who are deactivated or locked.
+func (uc *AuthUseCase) IntrospectAPIKey(ctx context.Context, plain string) (*dto.APIKeyIntrospection, error) {
+	inactive := &dto.APIKeyIntrospection{Active: false}
+	if !strings.HasPrefix(plain, apiKeyPrefix) {
+		return inactive, nil
+	}
+
+	key, err := uc.apiKeyRepo.FindByKeyHash(ctx, uc.tokenService.HashToken(plain))
+	if err != nil {
+		if errors.Is(err, domainErr.ErrAPIKeyNotFound) {
+			return inactive, nil
+		}
+		return nil, err
+	}
+	if !key.IsValid() {
+		return inactive, nil
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, key.UserID)
+	if err != nil {
+		if errors.Is(err, domainErr.ErrUserNotFound) {
+			return inactive, nil
+		}
+		return nil, err
+	}
+	if !user.IsActive || user.IsAccountLocked() {
+		return inactive, nil
+	}
+
+	if err := uc.apiKeyRepo.UpdateLastUsed(ctx, key.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &dto.APIKeyIntrospection{
+		Active:  true,
+		KeyID:   key.ID.String(),
+		Subject: user.ID.String(),
+		Email:   user.Email,
+		Role:    string(uc.effectiveRole(user)),
+		Scopes:  key.Scopes,
+	}, nil
+}
+
+func toAPIKeyDTO(key *entity.APIKey) *dto.APIKeyDTO {
+	return &dto.APIKeyDTO{
+		ID:         key.ID.String(),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
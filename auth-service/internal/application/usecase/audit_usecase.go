@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultActivityLimit = 20
+	maxActivityLimit     = 100
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+	auditExportBatchSize = 500
+)
+
+// GetMyActivity returns the most recent security events of the user.
+func (uc *AuthUseCase) GetMyActivity(ctx context.Context, userID string, limit, offset int) ([]*dto.AuditLogDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+	if limit > maxActivityLimit {
+		limit = maxActivityLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	logs, err := uc.auditLogRepo.FindByUserID(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return toAuditLogDTOs(logs), nil
+}
+
+func (uc *AuthUseCase) SearchAuditLogs(ctx context.Context, actorID string, req dto.SearchAuditLogsRequest) (*dto.AuditLogPage, error) {
+	if _, err := uc.requireAdmin(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	filter, err := parseAuditLogFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeAuditCursor(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+
+	// Fetch one extra entry to learn whether another page exists.
+	logs, err := uc.auditLogRepo.Search(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dto.AuditLogPage{}
+	if len(logs) > pageSize {
+		logs = logs[:pageSize]
+		page.NextPageToken = encodeAuditCursor(logs[pageSize-1])
+	}
+	page.Logs = toAuditLogDTOs(logs)
+
+	return page, nil
+}
+
+// StreamAuditLogs walks every entry matching the filter, newest first, and
+// passes each to emit. PageSize and PageToken of the request are ignored.
+func (uc *AuthUseCase) StreamAuditLogs(ctx context.Context, actorID string, req dto.SearchAuditLogsRequest, emit func(*dto.AuditLogDTO) error) error {
+	if _, err := uc.requireAdmin(ctx, actorID); err != nil {
+		return err
+	}
+
+	filter, err := parseAuditLogFilter(req)
+	if err != nil {
+		return err
+	}
+
+	var cursor *repository.AuditLogCursor
+	for {
+		logs, err := uc.auditLogRepo.Search(ctx, filter, cursor, auditExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := emit(toAuditLogDTO(log)); err != nil {
+				return err
+			}
+		}
+
+		if len(logs) < auditExportBatchSize {
+			return nil
+		}
+		last := logs[len(logs)-1]
+		cursor = &repository.AuditLogCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+func parseAuditLogFilter(req dto.SearchAuditLogsRequest) (repository.AuditLogFilter, error) {
+	var filter repository.AuditLogFilter
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return filter, domainErr.ErrInvalidInput
+		}
+		filter.UserID = &userID
+	}
+	for _, action := range req.Actions {
+		filter.Actions = append(filter.Actions, entity.AuditAction(action))
+	}
+	filter.IPAddress = req.IPAddress
+
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return filter, domainErr.ErrInvalidInput
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return filter, domainErr.ErrInvalidInput
+		}
+		filter.To = &to
+	}
+
+	return filter, nil
+}
+
+// Page tokens are opaque to clients: base64 of "<created_at unix nanos>:<id>".
+func encodeAuditCursor(log *entity.AuditLog) string {
+	raw := strconv.FormatInt(log.CreatedAt.UnixNano(), 10) + ":" + log.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeAuditCursor(token string) (*repository.AuditLogCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, domainErr.ErrInvalidInput
+	}
+	unixNanos, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+	logID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	return &repository.AuditLogCursor{CreatedAt: time.Unix(0, unixNanos), ID: logID}, nil
+}
+
+func toAuditLogDTOs(logs []*entity.AuditLog) []*dto.AuditLogDTO {
+	result := make([]*dto.AuditLogDTO, len(logs))
+	for i, log := range logs {
+		result[i] = toAuditLogDTO(log)
+	}
+	return result
+}
+
+func toAuditLogDTO(log *entity.AuditLog) *dto.AuditLogDTO {
+	return &dto.AuditLogDTO{
+		ID:        log.ID.String(),
+		UserID:    log.UserID.String(),
+		Action:    string(log.Action),
+		IPAddress: log.IPAddress,
+		UserAgent: log.UserAgent,
+		Metadata:  log.Metadata,
+		CreatedAt: log.CreatedAt,
+	}
+}
@@ -14,18 +14,54 @@ import (
 )
 
 type AuthUseCase struct {
-	userRepo           repository.UserRepository
-	refreshTokenRepo   repository.RefreshTokenRepository
-	tokenBlacklistRepo repository.TokenBlacklistRepository
-	auditLogRepo       repository.AuditLogRepository
-	passwordService    service.PasswordService
-	tokenService       service.TokenService
-	config             AuthConfig
+	userRepo              repository.UserRepository
+	refreshTokenRepo      repository.RefreshTokenRepository
+	tokenBlacklistRepo    repository.TokenBlacklistRepository
+	auditLogRepo          repository.AuditLogRepository
+	verificationTokenRepo repository.VerificationTokenRepository
+	recoveryCodeRepo      repository.RecoveryCodeRepository
+	oauthClientRepo       repository.OAuthClientRepository
+	authorizationCodeRepo repository.OAuthAuthorizationCodeRepository
+	oauthConsentRepo      repository.OAuthConsentRepository
+	apiKeyRepo            repository.APIKeyRepository
+	passwordHistoryRepo   repository.PasswordHistoryRepository
+	knownDeviceRepo       repository.KnownDeviceRepository
+	accountDeletionRepo   repository.AccountDeletionRepository
+	dataExportRepo        repository.DataExportRepository
+	emailChangeRepo       repository.EmailChangeRepository
+	passwordService       service.PasswordService
+	passwordPolicy        service.PasswordPolicy
+	tokenService          service.TokenService
+	keyManager            service.SigningKeyManager
+	mailSender            service.MailSender
+	totpService           service.TOTPService
+	secretCipher          service.SecretCipher
+	config                AuthConfig
 }
 
 type AuthConfig struct {
-	MaxLoginAttempts    int
-	AccountLockDuration time.Duration
+	MaxLoginAttempts         int
+	AccountLockDuration      time.Duration
+	RequireEmailVerification bool
+	VerificationTokenTTL     time.Duration
+	PasswordResetTokenTTL    time.Duration
+	MFAChallengeTTL          time.Duration
+	MFARequiredForAdmins     bool
+	RefreshReuseGracePeriod  time.Duration
+	AppBaseURL               string
+	OIDCIssuer               string
+	OAuthAuthorizeURL        string
+	AuthorizationCodeTTL     time.Duration
+	ServiceTokenTTL          time.Duration
+	APIKeyDefaultTTL         time.Duration
+	APIKeyMaxTTL             time.Duration
+	PasswordHistorySize      int
+	PasswordMaxAge           time.Duration
+	PasswordChangeTokenTTL   time.Duration
+	UnrecognizedLoginLinkTTL time.Duration
+	LoginStepUp              bool
+	LoginStepUpTTL           time.Duration
+	EmailChangeTokenTTL      time.Duration
 }
 
 func NewAuthUseCase(
@@ -33,18 +69,50 @@ func NewAuthUseCase(
 	refreshTokenRepo repository.RefreshTokenRepository,
 	tokenBlacklistRepo repository.TokenBlacklistRepository,
 	auditLogRepo repository.AuditLogRepository,
+	verificationTokenRepo repository.VerificationTokenRepository,
+	recoveryCodeRepo repository.RecoveryCodeRepository,
+	oauthClientRepo repository.OAuthClientRepository,
+	authorizationCodeRepo repository.OAuthAuthorizationCodeRepository,
+	oauthConsentRepo repository.OAuthConsentRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	passwordHistoryRepo repository.PasswordHistoryRepository,
+	knownDeviceRepo repository.KnownDeviceRepository,
+	accountDeletionRepo repository.AccountDeletionRepository,
+	dataExportRepo repository.DataExportRepository,
+	emailChangeRepo repository.EmailChangeRepository,
 	passwordService service.PasswordService,
+	passwordPolicy service.PasswordPolicy,
 	tokenService service.TokenService,
+	keyManager service.SigningKeyManager,
+	mailSender service.MailSender,
+	totpService service.TOTPService,
+	secretCipher service.SecretCipher,
 	config AuthConfig,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:           userRepo,
-		refreshTokenRepo:   refreshTokenRepo,
-		tokenBlacklistRepo: tokenBlacklistRepo,
-		auditLogRepo:       auditLogRepo,
-		passwordService:    passwordService,
-		tokenService:       tokenService,
-		config:             config,
+		userRepo:              userRepo,
+		refreshTokenRepo:      refreshTokenRepo,
+		tokenBlacklistRepo:    tokenBlacklistRepo,
+		auditLogRepo:          auditLogRepo,
+		verificationTokenRepo: verificationTokenRepo,
+		recoveryCodeRepo:      recoveryCodeRepo,
+		oauthClientRepo:       oauthClientRepo,
+		authorizationCodeRepo: authorizationCodeRepo,
+		oauthConsentRepo:      oauthConsentRepo,
+		apiKeyRepo:            apiKeyRepo,
+		passwordHistoryRepo:   passwordHistoryRepo,
+		knownDeviceRepo:       knownDeviceRepo,
+		accountDeletionRepo:   accountDeletionRepo,
+		dataExportRepo:        dataExportRepo,
+		emailChangeRepo:       emailChangeRepo,
+		passwordService:       passwordService,
+		passwordPolicy:        passwordPolicy,
+		tokenService:          tokenService,
+		keyManager:            keyManager,
+		mailSender:            mailSender,
+		totpService:           totpService,
+		secretCipher:          secretCipher,
+		config:                config,
 	}
 }
 
@@ -57,7 +125,7 @@ func (uc *AuthUseCase) Register(ctx context.Context, req dto.RegisterRequest) er
 		return domainErr.ErrUserAlreadyExists
 	}
 
-	if err := uc.passwordService.ValidatePasswordStrength(req.Password); err != nil {
+	if err := uc.passwordPolicy.Validate(req.Password, req.Email); err != nil {
 		return err
 	}
 
@@ -75,6 +143,9 @@ func (uc *AuthUseCase) Register(ctx context.Context, req dto.RegisterRequest) er
 	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionRegister, "", "")
 	_ = uc.auditLogRepo.Create(ctx, auditLog)
 
+	// A delivery failure must not fail registration; the user can request a new link.
+	_ = uc.sendVerificationEmail(ctx, user)
+
 	return nil
 }
 
@@ -107,30 +178,80 @@ func (uc *AuthUseCase) Login(ctx context.Context, req dto.LoginRequest, ipAddres
 		return nil, domainErr.ErrInvalidCredentials
 	}
 
+	// Upgrade hashes of an older algorithm or cost while the password is at
+	// hand. If it fails, the next login tries again.
+	if uc.passwordService.NeedsRehash(user.PasswordHash) {
+		if passwordHash, err := uc.passwordService.HashPassword(req.Password); err == nil {
+			user.RehashPassword(passwordHash)
+			_ = uc.userRepo.Update(ctx, user)
+		}
+	}
+
+	if user.PasswordResetRequired {
+		return nil, domainErr.ErrPasswordResetRequired
+	}
+
+	if uc.config.RequireEmailVerification && !user.IsVerified {
+		return nil, domainErr.ErrEmailNotVerified
+	}
+
+	if user.MFAEnabled {
+		return uc.startMFAChallenge(ctx, user)
+	}
+
+	return uc.completeLogin(ctx, user, ipAddress, userAgent)
+}
+
+// completeLogin finishes a login once every required factor has been checked,
+// unless the password has expired and must be changed first, or the login
+// needs a step-up verification.
+func (uc *AuthUseCase) completeLogin(ctx context.Context, user *entity.User, ipAddress, userAgent string) (*dto.AuthResponse, error) {
+	if uc.config.PasswordMaxAge > 0 && user.PasswordExpired(uc.config.PasswordMaxAge) {
+		return uc.startPasswordChange(ctx, user)
+	}
+
+	// MFA users have already passed a second factor.
+	if uc.config.LoginStepUp && !user.MFAEnabled {
+		device, err := uc.assessDevice(ctx, user.ID, ipAddress, userAgent)
+		if err != nil {
+			return nil, err
+		}
+		if device.risky() {
+			return uc.startLoginStepUp(ctx, user)
+		}
+	}
+
+	return uc.startSession(ctx, user, ipAddress, userAgent)
+}
+
+// startSession issues the token pair of a new session.
+func (uc *AuthUseCase) startSession(ctx context.Context, user *entity.User, ipAddress, userAgent string) (*dto.AuthResponse, error) {
 	user.ResetFailedLoginAttempts()
 	user.UpdateLastLogin(ipAddress)
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, domainErr.ErrDatabase
 	}
 
-	claims := service.TokenClaims{
-		UserID: user.ID.String(),
-		Email:  user.Email,
-		Role:   string(user.Role),
-	}
-
-	accessToken, err := uc.tokenService.GenerateAccessToken(claims)
+	refreshPlain, refreshHash, err := uc.tokenService.GenerateRefreshToken()
 	if err != nil {
 		return nil, domainErr.ErrInternalServer
 	}
 
-	refreshPlain, refreshHash, err := uc.tokenService.GenerateRefreshToken()
+	expiresAt := time.Now().Add(uc.tokenService.GetRefreshTokenExpiry())
+	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, expiresAt, ipAddress, userAgent)
+
+	claims := service.TokenClaims{
+		UserID:    user.ID.String(),
+		Email:     user.Email,
+		Role:      string(uc.effectiveRole(user)),
+		SessionID: refreshToken.TokenFamilyID.String(),
+	}
+
+	accessToken, err := uc.tokenService.GenerateAccessToken(claims)
 	if err != nil {
 		return nil, domainErr.ErrInternalServer
 	}
 
-	expiresAt := time.Now().Add(uc.tokenService.GetRefreshTokenExpiry())
-	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, expiresAt)
 	if err := uc.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
 		return nil, domainErr.ErrDatabase
 	}
@@ -138,6 +259,8 @@ func (uc *AuthUseCase) Login(ctx context.Context, req dto.LoginRequest, ipAddres
 	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLogin, ipAddress, userAgent)
 	_ = uc.auditLogRepo.Create(ctx, auditLog)
 
+	uc.rememberDevice(ctx, user, refreshToken.TokenFamilyID, ipAddress, userAgent)
+
 	return &dto.AuthResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshPlain,
@@ -156,32 +279,35 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain, accessTok
 		return nil, domainErr.ErrInvalidToken
 	}
 
-	if !token.IsValid() {
+	// Tokens issued to OAuth clients are refreshed by the client at the
+	// token endpoint.
+	if token.ClientID != nil {
 		return nil, domainErr.ErrInvalidToken
 	}
 
-	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	user, newRefreshToken, newRefreshPlain, err := uc.rotateRefreshToken(ctx, token, ipAddress, userAgent)
 	if err != nil {
-		return nil, domainErr.ErrUserNotFound
-	}
-
-	// Revoke the used refresh token
-	if err := uc.refreshTokenRepo.RevokeByTokenHash(ctx, refreshHash); err != nil {
-		return nil, domainErr.ErrDatabase
+		return nil, err
 	}
 
-	// Blacklist the access token
+	// Blacklist the access token being replaced. RefreshToken is
+	// authenticated by the refresh token alone, so the access token is only
+	// trusted if it is valid and belongs to the same user; an expired one
+	// needs no blacklisting.
 	if accessToken != "" {
-		accessHash := uc.tokenService.HashToken(accessToken)
-		expiresAt := time.Now().Add(uc.tokenService.GetAccessTokenExpiry())
-		blacklist := entity.NewTokenBlacklist(accessHash, expiresAt)
-		_ = uc.tokenBlacklistRepo.Add(ctx, blacklist)
+		if accessClaims, err := uc.tokenService.ValidateAccessToken(accessToken); err == nil && accessClaims.UserID == user.ID.String() {
+			accessHash := uc.tokenService.HashToken(accessToken)
+			expiresAt := time.Now().Add(uc.tokenService.GetAccessTokenExpiry())
+			blacklist := entity.NewTokenBlacklist(accessHash, expiresAt)
+			_ = uc.tokenBlacklistRepo.Add(ctx, blacklist)
+		}
 	}
 
 	claims := service.TokenClaims{
-		UserID: user.ID.String(),
-		Email:  user.Email,
-		Role:   string(user.Role),
+		UserID:    user.ID.String(),
+		Email:     user.Email,
+		Role:      string(uc.effectiveRole(user)),
+		SessionID: newRefreshToken.TokenFamilyID.String(),
 	}
 
 	newAccessToken, err := uc.tokenService.GenerateAccessToken(claims)
@@ -189,24 +315,74 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain, accessTok
 		return nil, domainErr.ErrInternalServer
 	}
 
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionTokenRefresh, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return &dto.RefreshTokenResponse{
+		AccessToken:  newAccessToken,
+		RefreshToken: newRefreshPlain,
+	}, nil
+}
+
+// rotateRefreshToken replaces token with a new one in the same family and
+// returns the owner along with the new token and its plain value. Presenting
+// a token that was already rotated is handled as reuse.
+func (uc *AuthUseCase) rotateRefreshToken(ctx context.Context, token *entity.RefreshToken, ipAddress, userAgent string) (*entity.User, *entity.RefreshToken, string, error) {
+	if token.IsRotated() {
+		return nil, nil, "", uc.handleRefreshTokenReuse(ctx, token, ipAddress, userAgent)
+	}
+
+	if !token.IsValid() {
+		return nil, nil, "", domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, "", domainErr.ErrUserNotFound
+	}
+	if !user.IsActive {
+		return nil, nil, "", domainErr.ErrAccountInactive
+	}
+
 	newRefreshPlain, newRefreshHash, err := uc.tokenService.GenerateRefreshToken()
 	if err != nil {
-		return nil, domainErr.ErrInternalServer
+		return nil, nil, "", domainErr.ErrInternalServer
 	}
 
 	expiresAt := time.Now().Add(uc.tokenService.GetRefreshTokenExpiry())
-	newRefreshToken := entity.NewRefreshTokenWithFamily(user.ID, newRefreshHash, expiresAt, token.TokenFamilyID)
+	newRefreshToken := token.Successor(newRefreshHash, expiresAt, ipAddress, userAgent)
+
+	// Revoke the used refresh token. Losing this race means another request
+	// rotated the same token a moment ago.
+	if err := uc.refreshTokenRepo.MarkRotated(ctx, token.ID, newRefreshToken.ID); err != nil {
+		return nil, nil, "", err
+	}
+
 	if err := uc.refreshTokenRepo.Create(ctx, newRefreshToken); err != nil {
-		return nil, domainErr.ErrDatabase
+		return nil, nil, "", domainErr.ErrDatabase
 	}
 
-	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionTokenRefresh, "", "")
+	return user, newRefreshToken, newRefreshPlain, nil
+}
+
+// handleRefreshTokenReuse is called when a refresh token that was already
+// rotated is presented again. Outside the grace period this means the token
+// was copied, so the whole family is revoked to cut off both holders.
+func (uc *AuthUseCase) handleRefreshTokenReuse(ctx context.Context, token *entity.RefreshToken, ipAddress, userAgent string) error {
+	if token.RotatedWithin(uc.config.RefreshReuseGracePeriod) {
+		return domainErr.ErrTokenRevoked
+	}
+
+	if err := uc.refreshTokenRepo.RevokeByTokenFamilyID(ctx, token.TokenFamilyID); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(token.UserID, entity.AuditActionTokenReuseDetected, ipAddress, userAgent)
+	auditLog.AddMetadata("token_family_id", token.TokenFamilyID.String())
+	auditLog.AddMetadata("token_id", token.ID.String())
 	_ = uc.auditLogRepo.Create(ctx, auditLog)
 
-	return &dto.RefreshTokenResponse{
-		AccessToken:  newAccessToken,
-		RefreshToken: newRefreshPlain,
-	}, nil
+	return domainErr.ErrTokenReuseDetected
 }
 
 func (uc *AuthUseCase) Logout(ctx context.Context, userID string, refreshPlain, accessToken, ipAddress, userAgent string) error {
@@ -235,12 +411,18 @@ func (uc *AuthUseCase) Logout(ctx context.Context, userID string, refreshPlain,
 	return nil
 }
 
-func (uc *AuthUseCase) LogoutAll(ctx context.Context, userID, accessToken, ipAddress, userAgent string) error {
+// LogoutAll revokes every session of the user. If keepSessionID is set, that
+// session (normally the caller's own) and its access token stay valid.
+func (uc *AuthUseCase) LogoutAll(ctx context.Context, userID, accessToken, keepSessionID, ipAddress, userAgent string) error {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return domainErr.ErrInvalidInput
 	}
 
+	if keepSessionID != "" {
+		return uc.revokeOtherSessions(ctx, userUUID, keepSessionID, ipAddress, userAgent)
+	}
+
 	// Revoke all refresh tokens of the user
 	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, userUUID); err != nil {
 		return domainErr.ErrDatabase
@@ -278,6 +460,7 @@ func (uc *AuthUseCase) GetMe(ctx context.Context, userID string) (*dto.UserDTO,
 		Role:       string(user.Role),
 		IsVerified: user.IsVerified,
 		IsActive:   user.IsActive,
+		MFAEnabled: user.MFAEnabled,
 		CreatedAt:  user.CreatedAt,
 	}, nil
 }
@@ -297,17 +480,14 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID string, req dt
 		return domainErr.ErrInvalidPassword
 	}
 
-	if err := uc.passwordService.ValidatePasswordStrength(req.NewPassword); err != nil {
+	if err := uc.validateNewPassword(ctx, user, req.NewPassword); err != nil {
 		return err
 	}
 
-	newPasswordHash, err := uc.passwordService.HashPassword(req.NewPassword)
-	if err != nil {
-		return domainErr.ErrInternalServer
+	if err := uc.replacePassword(ctx, user, req.NewPassword); err != nil {
+		return err
 	}
 
-	user.UpdatePassword(newPasswordHash)
-
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return domainErr.ErrDatabase
 	}
@@ -327,3 +507,11 @@ func (uc *AuthUseCase) GetPublicKey(ctx context.Context) string {
 func (uc *AuthUseCase) GetAlgorithm() string {
 	return uc.tokenService.GetAlgorithm()
 }
+
+func (uc *AuthUseCase) GetKeyID() string {
+	return uc.tokenService.GetKeyID()
+}
+
+func (uc *AuthUseCase) GetJWKS(ctx context.Context) []service.JSONWebKey {
+	return uc.tokenService.GetJWKS()
+}
@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+
+	"github.com/google/uuid"
+)
+
+// RequestDataExport starts building an archive of the caller's personal
+// data. While an export is still being built it is returned instead of a new
+// one being started.
+func (uc *AuthUseCase) RequestDataExport(ctx context.Context, userID, ipAddress, userAgent string) (*dto.DataExportDTO, error) {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := uc.dataExportRepo.FindPendingByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if export != nil {
+		return toDataExportDTO(export), nil
+	}
+
+	export = entity.NewDataExport(user.ID)
+	if err := uc.dataExportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionDataExportRequested, ipAddress, userAgent)
+	auditLog.AddMetadata("data_export_id", export.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toDataExportDTO(export), nil
+}
+
+func (uc *AuthUseCase) GetDataExport(ctx context.Context, userID, exportID string) (*dto.DataExportDTO, error) {
+	export, err := uc.findDataExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	return toDataExportDTO(export), nil
+}
+
+// DownloadDataExport returns the archive of a ready export that has not
+// expired.
+func (uc *AuthUseCase) DownloadDataExport(ctx context.Context, userID, exportID, ipAddress, userAgent string) (*dto.DataExportArchive, error) {
+	export, err := uc.findDataExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.IsExpired() {
+		return nil, domainErr.ErrDataExportExpired
+	}
+	if export.Status != entity.DataExportStatusReady {
+		return nil, domainErr.ErrDataExportNotReady
+	}
+
+	archive, err := uc.dataExportRepo.FindArchive(ctx, export.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(export.UserID, entity.AuditActionDataExportDownloaded, ipAddress, userAgent)
+	auditLog.AddMetadata("data_export_id", export.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return &dto.DataExportArchive{
+		FileName: "personal-data-" + export.ID.String() + ".zip",
+		Content:  archive,
+	}, nil
+}
+
+// findDataExport returns the user's export; another user's export is
+// reported as not found.
+func (uc *AuthUseCase) findDataExport(ctx context.Context, userID, exportID string) (*entity.DataExport, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+	exportUUID, err := uuid.Parse(exportID)
+	if err != nil {
+		return nil, domainErr.ErrDataExportNotFound
+	}
+
+	export, err := uc.dataExportRepo.FindByID(ctx, exportUUID)
+	if err != nil {
+		return nil, err
+	}
+	if export.UserID != userUUID {
+		return nil, domainErr.ErrDataExportNotFound
+	}
+	return export, nil
+}
+
+func toDataExportDTO(export *entity.DataExport) *dto.DataExportDTO {
+	return &dto.DataExportDTO{
+		ID:          export.ID.String(),
+		Status:      string(export.Status),
+		SizeBytes:   export.SizeBytes,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+	}
+}
+
+// DataExportUseCase builds the archives of requested personal data exports.
+// It is run periodically by the scheduler. An export whose data cannot be
+// collected from every service fails, and the user requests a new one.
+type DataExportUseCase struct {
+	userRepo        repository.UserRepository
+	auditLogRepo    repository.AuditLogRepository
+	dataExportRepo  repository.DataExportRepository
+	profileExporter service.ProfileExporter
+	orderExporter   service.OrderExporter
+	config          DataExportConfig
+}
+
+// DataExportConfig sets how many exports one run builds, how long a call to
+// another service may take, and how long an archive can be downloaded.
+type DataExportConfig struct {
+	BatchSize   int
+	CallTimeout time.Duration
+	TTL         time.Duration
+}
+
+func NewDataExportUseCase(
+	userRepo repository.UserRepository,
+	auditLogRepo repository.AuditLogRepository,
+	dataExportRepo repository.DataExportRepository,
+	profileExporter service.ProfileExporter,
+	orderExporter service.OrderExporter,
+	config DataExportConfig,
+) *DataExportUseCase {
+	return &DataExportUseCase{
+		userRepo:        userRepo,
+		auditLogRepo:    auditLogRepo,
+		dataExportRepo:  dataExportRepo,
+		profileExporter: profileExporter,
+		orderExporter:   orderExporter,
+		config:          config,
+	}
+}
+
+// ProcessPendingExports builds the pending exports and returns how many of
+// them are ready.
+func (uc *DataExportUseCase) ProcessPendingExports(ctx context.Context) (int64, error) {
+	exports, err := uc.dataExportRepo.ListPending(ctx, uc.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var ready int64
+	for _, export := range exports {
+		archive, err := uc.buildArchive(ctx, export)
+		if err != nil {
+			export.Fail(err.Error(), uc.config.TTL)
+		} else {
+			export.Complete(int64(len(archive)), uc.config.TTL)
+		}
+		if err := uc.dataExportRepo.Update(ctx, export, archive); err != nil {
+			return ready, err
+		}
+		if export.Status == entity.DataExportStatusReady {
+			ready++
+		}
+	}
+	return ready, nil
+}
+
+// exportAuditLogPageSize is how many audit log entries are read at a time.
+const exportAuditLogPageSize = 500
+
+// exportFormatVersion is raised when the layout of the archive changes.
+const exportFormatVersion = 1
+
+type exportManifest struct {
+	FormatVersion int                  `json:"format_version"`
+	ExportID      string               `json:"export_id"`
+	UserID        string               `json:"user_id"`
+	GeneratedAt   time.Time            `json:"generated_at"`
+	Files         []exportManifestFile `json:"files"`
+}
+
+type exportManifestFile struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	Description string `json:"description"`
+	Records     int    `json:"records"`
+	SHA256      string `json:"sha256"`
+}
+
+type exportedAccount struct {
+	ID                string     `json:"id"`
+	Email             string     `json:"email"`
+	Role              string     `json:"role"`
+	IsVerified        bool       `json:"is_verified"`
+	IsActive          bool       `json:"is_active"`
+	MFAEnabled        bool       `json:"mfa_enabled"`
+	LastLoginAt       *time.Time `json:"last_login_at"`
+	LastLoginIP       string     `json:"last_login_ip"`
+	PasswordChangedAt *time.Time `json:"password_changed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+type exportedAuditLog struct {
+	ID        string                 `json:"id"`
+	Action    string                 `json:"action"`
+	IPAddress string                 `json:"ip_address"`
+	UserAgent string                 `json:"user_agent"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// exportFile is one JSON file of the archive, described in the manifest.
+type exportFile struct {
+	name        string
+	source      string
+	description string
+	records     int
+	data        interface{}
+}
+
+// buildArchive collects the user's data from every service and returns it
+// as a ZIP of JSON files, with manifest.json listing them.
+func (uc *DataExportUseCase) buildArchive(ctx context.Context, export *entity.DataExport) ([]byte, error) {
+	user, err := uc.userRepo.FindByID(ctx, export.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found")
+	}
+	auditLogs, err := uc.collectAuditLogs(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("audit logs: %w", err)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, uc.config.CallTimeout)
+	profile, err := uc.profileExporter.ExportUserProfile(callCtx, user.ID.String())
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("user-service: %w", err)
+	}
+	callCtx, cancel = context.WithTimeout(ctx, uc.config.CallTimeout)
+	orders, err := uc.orderExporter.ExportUserOrders(callCtx, user.ID.String())
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("order-service: %w", err)
+	}
+	if orders == nil {
+		orders = []*service.ExportedOrder{}
+	}
+
+	profileRecords := 0
+	if profile != nil {
+		profileRecords = 1
+	}
+	files := []exportFile{
+		{
+			name:        "account.json",
+			source:      "auth-service",
+			description: "Login account: email, role, verification, MFA and last login",
+			records:     1,
+			data: exportedAccount{
+				ID:                user.ID.String(),
+				Email:             user.Email,
+				Role:              string(user.Role),
+				IsVerified:        user.IsVerified,
+				IsActive:          user.IsActive,
+				MFAEnabled:        user.MFAEnabled,
+				LastLoginAt:       user.LastLoginAt,
+				LastLoginIP:       user.LastLoginIP,
+				PasswordChangedAt: user.PasswordChangedAt,
+				CreatedAt:         user.CreatedAt,
+				UpdatedAt:         user.UpdatedAt,
+			},
+		},
+		{
+			name:        "audit_logs.json",
+			source:      "auth-service",
+			description: "Security events of the account, newest first",
+			records:     len(auditLogs),
+			data:        auditLogs,
+		},
+		{
+			name:        "profile.json",
+			source:      "user-service",
+			description: "Profile: name, phone and address; null if none was created",
+			records:     profileRecords,
+			data:        profile,
+		},
+		{
+			name:        "orders.json",
+			source:      "order-service",
+			description: "Orders with their items and shipping details, newest first",
+			records:     len(orders),
+			data:        orders,
+		},
+	}
+
+	return writeExportArchive(export, files)
+}
+
+func (uc *DataExportUseCase) collectAuditLogs(ctx context.Context, userID uuid.UUID) ([]exportedAuditLog, error) {
+	filter := repository.AuditLogFilter{UserID: &userID}
+	result := []exportedAuditLog{}
+	var after *repository.AuditLogCursor
+	for {
+		logs, err := uc.auditLogRepo.Search(ctx, filter, after, exportAuditLogPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			result = append(result, exportedAuditLog{
+				ID:        log.ID.String(),
+				Action:    string(log.Action),
+				IPAddress: log.IPAddress,
+				UserAgent: log.UserAgent,
+				Metadata:  log.Metadata,
+				CreatedAt: log.CreatedAt,
+			})
+		}
+		if len(logs) < exportAuditLogPageSize {
+			return result, nil
+		}
+		last := logs[len(logs)-1]
+		after = &repository.AuditLogCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+func writeExportArchive(export *entity.DataExport, files []exportFile) ([]byte, error) {
+	manifest := exportManifest{
+		FormatVersion: exportFormatVersion,
+		ExportID:      export.ID.String(),
+		UserID:        export.UserID.String(),
+		GeneratedAt:   time.Now().UTC(),
+	}
+	contents := make([][]byte, len(files))
+	for i, file := range files {
+		content, err := json.MarshalIndent(file.data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(content)
+		contents[i] = content
+		manifest.Files = append(manifest.Files, exportManifestFile{
+			Name:        file.name,
+			Source:      file.source,
+			Description: file.description,
+			Records:     file.records,
+			SHA256:      hex.EncodeToString(sum[:]),
+		})
+	}
+	manifestContent, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if err := writeZipFile(zw, "manifest.json", manifestContent, manifest.GeneratedAt); err != nil {
+		return nil, err
+	}
+	for i, file := range files {
+		if err := writeZipFile(zw, file.name, contents[i], manifest.GeneratedAt); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeZipFile(zw *zip.Writer, name string, content []byte, modified time.Time) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+	"auth-service/pkg/utils"
+)
+
+// RequestEmailChange starts moving the caller's account to a new email once
+// they have confirmed it with their password and, with MFA enabled, a TOTP
+// or recovery code. Nothing changes until the link mailed to the new address
+// is opened; the current address is told about the request so that a
+// stolen session cannot take the account over quietly.
+func (uc *AuthUseCase) RequestEmailChange(ctx context.Context, userID string, req dto.RequestEmailChangeRequest, ipAddress, userAgent string) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.passwordService.VerifyPassword(user.PasswordHash, req.Password); err != nil {
+		return domainErr.ErrInvalidPassword
+	}
+	if user.MFAEnabled {
+		if _, err := uc.verifySecondFactor(ctx, user, req.Code); err != nil {
+			return err
+		}
+	}
+
+	newEmail := strings.TrimSpace(req.NewEmail)
+	if address, err := mail.ParseAddress(newEmail); err != nil || address.Address != newEmail {
+		return domainErr.ErrInvalidInput
+	}
+	if strings.EqualFold(newEmail, user.Email) {
+		return domainErr.ErrInvalidInput
+	}
+	exists, err := uc.userRepo.ExistsByEmail(ctx, newEmail)
+	if err != nil {
+		return domainErr.ErrDatabase
+	}
+	if exists {
+		return domainErr.ErrUserAlreadyExists
+	}
+
+	plain, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return domainErr.ErrInternalServer
+	}
+	expiresAt := time.Now().Add(uc.config.EmailChangeTokenTTL)
+	change := entity.NewEmailChange(user.ID, user.Email, newEmail, uc.tokenService.HashToken(plain), expiresAt)
+	if err := uc.emailChangeRepo.Create(ctx, change); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionEmailChangeRequested, ipAddress, userAgent)
+	auditLog.AddMetadata("new_email", newEmail)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	_ = uc.mailSender.Send(ctx, service.MailMessage{
+		To:      user.Email,
+		Subject: "Your email address is being changed",
+		Body: fmt.Sprintf(
+			"Someone asked to change the email address of your account to %s.\n\nThe change takes effect only once it is confirmed from that address. If this wasn't you, change your password and sign out of all sessions.\n",
+			newEmail,
+		),
+	})
+
+	link := fmt.Sprintf("%s/confirm-email-change?token=%s", uc.config.AppBaseURL, url.QueryEscape(plain))
+	return uc.mailSender.Send(ctx, service.MailMessage{
+		To:      newEmail,
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf(
+			"Please confirm that you want to use this address for your account by opening the link below:\n\n%s\n\nThe link expires in %s and can be used once.\n",
+			link, uc.config.EmailChangeTokenTTL,
+		),
+	})
+}
+
+// ConfirmEmailChange applies the email change the token was mailed for. The
+// new address is checked again, since it may have been registered in the
+// meantime, and every session ends, as after a password reset. Links still
+// pending for the old address are invalidated.
+func (uc *AuthUseCase) ConfirmEmailChange(ctx context.Context, token, ipAddress, userAgent string) error {
+	if token == "" {
+		return domainErr.ErrMissingToken
+	}
+
+	change, err := uc.emailChangeRepo.FindByTokenHash(ctx, uc.tokenService.HashToken(token))
+	if err != nil {
+		return err
+	}
+	if !change.IsPending() {
+		return domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, change.UserID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+	// A change requested before another one was confirmed is stale.
+	if user.Email != change.OldEmail {
+		return domainErr.ErrInvalidToken
+	}
+
+	user.ChangeEmail(change.NewEmail)
+	change.Confirm()
+	if err := uc.emailChangeRepo.Confirm(ctx, user, change); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return domainErr.ErrDatabase
+	}
+	for _, purpose := range []entity.TokenPurpose{entity.TokenPurposeEmailVerification, entity.TokenPurposePasswordReset} {
+		if err := uc.verificationTokenRepo.InvalidateByUserID(ctx, user.ID, purpose); err != nil {
+			return err
+		}
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionEmailChanged, ipAddress, userAgent)
+	auditLog.AddMetadata("old_email", change.OldEmail)
+	auditLog.AddMetadata("new_email", change.NewEmail)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// EmailSyncUseCase tells the user-service about confirmed email changes. It
+// is run periodically by the scheduler and retries each change until the
+// user-service confirms. It always sends the account's current email, so a
+// late retry cannot bring back an address the user has since changed again.
+type EmailSyncUseCase struct {
+	userRepo        repository.UserRepository
+	emailChangeRepo repository.EmailChangeRepository
+	emailSyncer     service.EmailSyncer
+	config          EmailSyncConfig
+}
+
+// EmailSyncConfig sets how many changes one run handles, how long a call to
+// the user-service may take, and the delay after a failed attempt, from
+// RetryBaseDelay doubling up to RetryMaxDelay.
+type EmailSyncConfig struct {
+	BatchSize      int
+	CallTimeout    time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+func NewEmailSyncUseCase(
+	userRepo repository.UserRepository,
+	emailChangeRepo repository.EmailChangeRepository,
+	emailSyncer service.EmailSyncer,
+	config EmailSyncConfig,
+) *EmailSyncUseCase {
+	return &EmailSyncUseCase{
+		userRepo:        userRepo,
+		emailChangeRepo: emailChangeRepo,
+		emailSyncer:     emailSyncer,
+		config:          config,
+	}
+}
+
+// ProcessUnsyncedChanges syncs the changes that are due and returns how many
+// of them succeeded.
+func (uc *EmailSyncUseCase) ProcessUnsyncedChanges(ctx context.Context) (int64, error) {
+	changes, err := uc.emailChangeRepo.ListUnsynced(ctx, time.Now(), uc.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var synced int64
+	for _, change := range changes {
+		if err := uc.sync(ctx, change); err != nil {
+			change.FailSync("user-service: "+err.Error(), uc.config.RetryBaseDelay, uc.config.RetryMaxDelay)
+		} else {
+			change.MarkSynced()
+			synced++
+		}
+		if err := uc.emailChangeRepo.Update(ctx, change); err != nil {
+			return synced, err
+		}
+	}
+	return synced, nil
+}
+
+func (uc *EmailSyncUseCase) sync(ctx context.Context, change *entity.EmailChange) error {
+	user, err := uc.userRepo.FindByID(ctx, change.UserID)
+	if err != nil {
+		// The profile of a deleted account is erased instead.
+		if errors.Is(err, domainErr.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uc.config.CallTimeout)
+	defer cancel()
+	return uc.emailSyncer.SyncUserEmail(ctx, user.ID.String(), user.Email)
+}
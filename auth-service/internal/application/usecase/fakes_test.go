@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+
+	"github.com/google/uuid"
+)
+
+// The fakes below keep state in memory for usecase tests. Those that embed a
+// domain interface only implement the methods the tested flows call; any
+// other call panics on the nil interface.
+
+type memoryVerificationTokens struct {
+	mu     sync.Mutex
+	tokens map[uuid.UUID]*entity.VerificationToken
+}
+
+func newMemoryVerificationTokens() *memoryVerificationTokens {
+	return &memoryVerificationTokens{tokens: map[uuid.UUID]*entity.VerificationToken{}}
+}
+
+func (r *memoryVerificationTokens) Create(ctx context.Context, token *entity.VerificationToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *token
+	r.tokens[token.ID] = &stored
+	return nil
+}
+
+func (r *memoryVerificationTokens) FindByTokenHash(ctx context.Context, tokenHash string, purpose entity.TokenPurpose) (*entity.VerificationToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash && token.Purpose == purpose {
+			found := *token
+			return &found, nil
+		}
+	}
+	return nil, domainErr.ErrInvalidToken
+}
+
+func (r *memoryVerificationTokens) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, ok := r.tokens[id]
+	if !ok || token.UsedAt != nil {
+		return domainErr.ErrInvalidToken
+	}
+	now := time.Now()
+	token.UsedAt = &now
+	return nil
+}
+
+func (r *memoryVerificationTokens) InvalidateByUserID(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.Purpose == purpose && token.UsedAt == nil {
+			token.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *memoryVerificationTokens) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+// expire moves the expiry of every stored token into the past.
+func (r *memoryVerificationTokens) expire() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, token := range r.tokens {
+		token.ExpiresAt = time.Now().Add(-time.Second)
+	}
+}
+
+type memoryUsers struct {
+	repository.UserRepository
+
+	mu    sync.Mutex
+	users map[uuid.UUID]*entity.User
+}
+
+func newMemoryUsers(users ...*entity.User) *memoryUsers {
+	r := &memoryUsers{users: map[uuid.UUID]*entity.User{}}
+	for _, user := range users {
+		stored := *user
+		r.users[user.ID] = &stored
+	}
+	return r
+}
+
+func (r *memoryUsers) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, domainErr.ErrUserNotFound
+	}
+	found := *user
+	return &found, nil
+}
+
+func (r *memoryUsers) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, user := range r.users {
+		if user.Email == email {
+			found := *user
+			return &found, nil
+		}
+	}
+	return nil, domainErr.ErrUserNotFound
+}
+
+func (r *memoryUsers) Update(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *memoryUsers) UseMFAStep(ctx context.Context, id uuid.UUID, step int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok || step <= user.MFALastUsedStep {
+		return domainErr.ErrInvalidMFACode
+	}
+	user.MFALastUsedStep = step
+	return nil
+}
+
+// memoryRefreshTokens keeps tokens and records which users had all their
+// sessions revoked.
+type memoryRefreshTokens struct {
+	repository.RefreshTokenRepository
+
+	tokens       []*entity.RefreshToken
+	revokedUsers []uuid.UUID
+}
+
+func (r *memoryRefreshTokens) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.RefreshToken, error) {
+	var active []*entity.RefreshToken
+	for _, token := range r.tokens {
+		if token.UserID == userID && !token.IsRevoked {
+			active = append(active, token)
+		}
+	}
+	return active, nil
+}
+
+func (r *memoryRefreshTokens) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
+	r.revokedUsers = append(r.revokedUsers, userID)
+	return nil
+}
+
+func (r *memoryRefreshTokens) RevokeByTokenFamilyID(ctx context.Context, familyID uuid.UUID) error {
+	for _, token := range r.tokens {
+		if token.TokenFamilyID == familyID {
+			token.IsRevoked = true
+		}
+	}
+	return nil
+}
+
+// memoryKnownDevices implements only Delete, which it records.
+type memoryKnownDevices struct {
+	repository.KnownDeviceRepository
+
+	deleted []string
+}
+
+func (r *memoryKnownDevices) Delete(ctx context.Context, userID uuid.UUID, fingerprint, ipPrefix string) error {
+	r.deleted = append(r.deleted, fingerprint+"@"+ipPrefix)
+	return nil
+}
+
+type discardAuditLogs struct {
+	repository.AuditLogRepository
+}
+
+func (discardAuditLogs) Create(ctx context.Context, log *entity.AuditLog) error {
+	return nil
+}
+
+type capturedMail struct {
+	messages []service.MailMessage
+}
+
+func (m *capturedMail) Send(ctx context.Context, msg service.MailMessage) error {
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// hashingTokens implements only HashToken of the token service.
+type hashingTokens struct {
+	service.TokenService
+}
+
+func (hashingTokens) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (hashingTokens) GetAccessTokenExpiry() time.Duration {
+	return 15 * time.Minute
+}
+
+// memoryBlacklist records the hashes added to the token blacklist.
+type memoryBlacklist struct {
+	repository.TokenBlacklistRepository
+
+	hashes []string
+}
+
+func (r *memoryBlacklist) Add(ctx context.Context, blacklist *entity.TokenBlacklist) error {
+	r.hashes = append(r.hashes, blacklist.TokenHash)
+	return nil
+}
+
+// plainPasswords "hashes" a password by prefixing it, which is enough to
+// tell which password is stored.
+type plainPasswords struct{}
+
+func (plainPasswords) HashPassword(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (plainPasswords) VerifyPassword(hashedPassword, password string) error {
+	if hashedPassword != "hashed:"+password {
+		return domainErr.ErrInvalidPassword
+	}
+	return nil
+}
+
+func (plainPasswords) NeedsRehash(hashedPassword string) bool {
+	return false
+}
+
+type acceptAllPasswords struct{}
+
+func (acceptAllPasswords) Validate(password, email string) error {
+	return nil
+}
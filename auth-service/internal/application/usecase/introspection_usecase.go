@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// IntrospectToken reports whether an access token may still be used, in the
+// manner of RFC 7662. Besides the signature, expiry and issuer it checks the
+// blacklist and that the user is still active and not locked, which a JWT
+// alone cannot tell. Unusable tokens are reported as inactive without a
+// reason; an error means the answer is unknown and must not be cached. The
+// email is the account's current one, which differs from the token's after
+// an email change.
+func (uc *AuthUseCase) IntrospectToken(ctx context.Context, token string) (*dto.TokenIntrospection, error) {
+	inactive := &dto.TokenIntrospection{Active: false}
+	if token == "" {
+		return inactive, nil
+	}
+
+	claims, err := uc.tokenService.ValidateAccessToken(token)
+	if err != nil {
+		return inactive, nil
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return inactive, nil
+	}
+
+	for _, key := range []string{uc.tokenService.HashToken(token), entity.UserRevocationKey(userID)} {
+		blacklisted, err := uc.tokenBlacklistRepo.IsBlacklisted(ctx, key)
+		if err != nil {
+			return nil, domainErr.ErrDatabase
+		}
+		if blacklisted {
+			return inactive, nil
+		}
+	}
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domainErr.ErrUserNotFound) {
+			return inactive, nil
+		}
+		return nil, err
+	}
+	if !user.IsActive || user.IsAccountLocked() {
+		return inactive, nil
+	}
+
+	return &dto.TokenIntrospection{
+		Active:    true,
+		Subject:   claims.UserID,
+		Email:     user.Email,
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		Issuer:    claims.Issuer,
+		IssuedAt:  claims.IssuedAt,
+		ExpiresAt: claims.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/service"
+	"auth-service/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Every session start is checked against the user's known devices: the user
+// agent fingerprints and IP prefixes of earlier logins. A login from a
+// device or network none of them match is audited and reported to the user
+// by email, with a link that ends the session. The first login of a user is
+// not reported, as there is nothing to compare it with.
+
+const stepUpCodeDigits = 6
+
+type deviceAssessment struct {
+	hasHistory bool
+	newDevice  bool
+	newNetwork bool
+}
+
+// unrecognized reports whether the login is from a device or network the
+// user has not logged in from before.
+func (a deviceAssessment) unrecognized() bool {
+	return a.hasHistory && (a.newDevice || a.newNetwork)
+}
+
+// risky reports whether both the device and the network are new.
+func (a deviceAssessment) risky() bool {
+	return a.hasHistory && a.newDevice && a.newNetwork
+}
+
+func (uc *AuthUseCase) assessDevice(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) (deviceAssessment, error) {
+	devices, err := uc.knownDeviceRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return deviceAssessment{}, err
+	}
+
+	fingerprint := entity.DeviceFingerprint(userAgent)
+	prefix := entity.NetworkPrefix(ipAddress)
+
+	// An address that does not parse cannot be compared, so it does not
+	// count as a new network.
+	assessment := deviceAssessment{hasHistory: len(devices) > 0, newDevice: true, newNetwork: prefix != ""}
+	for _, device := range devices {
+		if device.Fingerprint == fingerprint {
+			assessment.newDevice = false
+		}
+		if device.IPPrefix == prefix {
+			assessment.newNetwork = false
+		}
+	}
+	return assessment, nil
+}
+
+// rememberDevice records the device of a new session and reports it if it
+// is unrecognized. It is best effort: a failure does not fail the login.
+func (uc *AuthUseCase) rememberDevice(ctx context.Context, user *entity.User, sessionID uuid.UUID, ipAddress, userAgent string) {
+	assessment, err := uc.assessDevice(ctx, user.ID, ipAddress, userAgent)
+	if err != nil {
+		return
+	}
+	_ = uc.knownDeviceRepo.Upsert(ctx, entity.NewKnownDevice(user.ID, ipAddress, userAgent))
+
+	if !assessment.unrecognized() {
+		return
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionNewDeviceLogin, ipAddress, userAgent)
+	auditLog.AddMetadata("session_id", sessionID.String())
+	auditLog.AddMetadata("new_device", assessment.newDevice)
+	auditLog.AddMetadata("new_network", assessment.newNetwork)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	_ = uc.sendNewDeviceEmail(ctx, user, sessionID, ipAddress, userAgent)
+}
+
+// sendNewDeviceEmail emails a link that reports the session. Only the hash
+// of its token and the session ID together is stored, so the token cannot
+// end any other session.
+func (uc *AuthUseCase) sendNewDeviceEmail(ctx context.Context, user *entity.User, sessionID uuid.UUID, ipAddress, userAgent string) error {
+	plain, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return domainErr.ErrInternalServer
+	}
+	report := entity.NewVerificationToken(user.ID, uc.reportTokenHash(plain, sessionID), entity.TokenPurposeUnrecognizedLogin, time.Now().Add(uc.config.UnrecognizedLoginLinkTTL))
+	if err := uc.verificationTokenRepo.Create(ctx, report); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	if userAgent == "" {
+		userAgent = "unknown"
+	}
+	if ipAddress == "" {
+		ipAddress = "unknown"
+	}
+
+	link := fmt.Sprintf("%s/unrecognized-login?token=%s&session=%s", uc.config.AppBaseURL, url.QueryEscape(plain), sessionID)
+	return uc.mailSender.Send(ctx, service.MailMessage{
+		To:      user.Email,
+		Subject: "New sign-in to your account",
+		Body: fmt.Sprintf(
+			"Your account was just signed in to from a new device or location.\n\nTime: %s\nIP address: %s\nDevice: %s\n\nIf this was you, you can ignore this email. If it was not, open the link below to sign that session out, then reset your password:\n\n%s\n\nThe link expires in %s.\n",
+			time.Now().UTC().Format(time.RFC1123), ipAddress, userAgent, link, uc.config.UnrecognizedLoginLinkTTL,
+		),
+	})
+}
+
+// ReportUnrecognizedLogin ends the session a new-device email was sent for
+// and forgets its device, so a later login from it is reported again. The
+// token only matches the session it was sent for. A session that has
+// already ended is not an error.
+func (uc *AuthUseCase) ReportUnrecognizedLogin(ctx context.Context, req dto.ReportUnrecognizedLoginRequest, ipAddress, userAgent string) error {
+	if req.Token == "" {
+		return domainErr.ErrMissingToken
+	}
+	familyID, err := uuid.Parse(req.SessionID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+
+	report, err := uc.verificationTokenRepo.FindByTokenHash(ctx, uc.reportTokenHash(req.Token, familyID), entity.TokenPurposeUnrecognizedLogin)
+	if err != nil {
+		return err
+	}
+	if !report.IsValid() {
+		return domainErr.ErrInvalidToken
+	}
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, report.ID); err != nil {
+		return err
+	}
+
+	sessions, err := uc.activeSessions(ctx, report.UserID)
+	if err != nil {
+		return err
+	}
+	session, active := sessions[familyID]
+	if active {
+		if err := uc.refreshTokenRepo.RevokeByTokenFamilyID(ctx, familyID); err != nil {
+			return domainErr.ErrDatabase
+		}
+		fingerprint := entity.DeviceFingerprint(session.UserAgent)
+		if err := uc.knownDeviceRepo.Delete(ctx, report.UserID, fingerprint, entity.NetworkPrefix(session.IPAddress)); err != nil {
+			return err
+		}
+	}
+
+	auditLog := entity.NewAuditLog(report.UserID, entity.AuditActionUnrecognizedLoginReported, ipAddress, userAgent)
+	auditLog.AddMetadata("session_id", familyID.String())
+	auditLog.AddMetadata("session_revoked", active)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+func (uc *AuthUseCase) reportTokenHash(token string, sessionID uuid.UUID) string {
+	return uc.tokenService.HashToken(token + ":" + sessionID.String())
+}
+
+// startLoginStepUp ends a login from an unknown device and network without
+// tokens and emails the user a code. VerifyLoginStepUp takes the returned
+// token and the code and completes the login. Only the hash of the token
+// and code together is stored, so the code is bound to this login.
+func (uc *AuthUseCase) startLoginStepUp(ctx context.Context, user *entity.User) (*dto.AuthResponse, error) {
+	for _, purpose := range []entity.TokenPurpose{entity.TokenPurposeLoginStepUp, entity.TokenPurposeLoginStepUpCode} {
+		if err := uc.verificationTokenRepo.InvalidateByUserID(ctx, user.ID, purpose); err != nil {
+			return nil, err
+		}
+	}
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposeLoginStepUp, uc.config.LoginStepUpTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := generateStepUpCode()
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+	codeToken := entity.NewVerificationToken(user.ID, uc.stepUpCodeHash(plain, code), entity.TokenPurposeLoginStepUpCode, time.Now().Add(uc.config.LoginStepUpTTL))
+	if err := uc.verificationTokenRepo.Create(ctx, codeToken); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	// Unlike other emails, the login cannot go on without this one.
+	if err := uc.mailSender.Send(ctx, service.MailMessage{
+		To:      user.Email,
+		Subject: "Your sign-in code",
+		Body: fmt.Sprintf(
+			"Someone is signing in to your account from a new device and location.\n\nIf this is you, enter this code to continue:\n\n%s\n\nThe code expires in %s. If this is not you, do not share the code and reset your password.\n",
+			code, uc.config.LoginStepUpTTL,
+		),
+	}); err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	return &dto.AuthResponse{
+		StepUpRequired: true,
+		StepUpToken:    plain,
+	}, nil
+}
+
+// VerifyLoginStepUp trades a login_step_up token and the emailed code for a
+// token pair. Like VerifyMFA, wrong codes count as failed logins.
+func (uc *AuthUseCase) VerifyLoginStepUp(ctx context.Context, req dto.VerifyLoginStepUpRequest, ipAddress, userAgent string) (*dto.AuthResponse, error) {
+	if req.StepUpToken == "" {
+		return nil, domainErr.ErrMissingToken
+	}
+
+	challenge, err := uc.verificationTokenRepo.FindByTokenHash(ctx, uc.tokenService.HashToken(req.StepUpToken), entity.TokenPurposeLoginStepUp)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.IsValid() {
+		return nil, domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidToken
+	}
+	if !user.IsActive {
+		return nil, domainErr.ErrAccountInactive
+	}
+	if user.IsAccountLocked() {
+		return nil, domainErr.ErrAccountLocked
+	}
+
+	code, err := uc.verificationTokenRepo.FindByTokenHash(ctx, uc.stepUpCodeHash(req.StepUpToken, strings.TrimSpace(req.Code)), entity.TokenPurposeLoginStepUpCode)
+	if err != nil && !errors.Is(err, domainErr.ErrInvalidToken) {
+		return nil, err
+	}
+	if err != nil || !code.IsValid() || code.UserID != user.ID {
+		user.IncrementFailedLoginAttempts(uc.config.MaxLoginAttempts, uc.config.AccountLockDuration)
+		_ = uc.userRepo.Update(ctx, user)
+
+		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLoginStepUpFailed, ipAddress, userAgent)
+		_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+		return nil, domainErr.ErrInvalidStepUpCode
+	}
+
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+	_ = uc.verificationTokenRepo.MarkUsed(ctx, code.ID)
+
+	return uc.startSession(ctx, user, ipAddress, userAgent)
+}
+
+func (uc *AuthUseCase) stepUpCodeHash(stepUpToken, code string) string {
+	return uc.tokenService.HashToken(stepUpToken + ":" + code)
+}
+
+func generateStepUpCode() (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(stepUpCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", stepUpCodeDigits, n), nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+var reportLinkPattern = regexp.MustCompile(`/unrecognized-login\?token=([^&\s]+)`)
+
+func TestReportTokenOnlyEndsItsOwnSession(t *testing.T) {
+	ctx := context.Background()
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	reported := entity.NewRefreshToken(user.ID, "reported", time.Now().Add(time.Hour), "203.0.113.7", "intruder")
+	current := entity.NewRefreshToken(user.ID, "current", time.Now().Add(time.Hour), "198.51.100.1", "owner")
+	sessions := &memoryRefreshTokens{tokens: []*entity.RefreshToken{reported, current}}
+	mail := &capturedMail{}
+	uc := &AuthUseCase{
+		verificationTokenRepo: newMemoryVerificationTokens(),
+		refreshTokenRepo:      sessions,
+		knownDeviceRepo:       &memoryKnownDevices{},
+		auditLogRepo:          discardAuditLogs{},
+		tokenService:          hashingTokens{},
+		mailSender:            mail,
+		config:                AuthConfig{UnrecognizedLoginLinkTTL: time.Hour},
+	}
+
+	require.NoError(t, uc.sendNewDeviceEmail(ctx, user, reported.TokenFamilyID, reported.IPAddress, reported.UserAgent))
+	require.Len(t, mail.messages, 1)
+	match := reportLinkPattern.FindStringSubmatch(mail.messages[0].Body)
+	require.NotNil(t, match)
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+
+	// The intruder cannot use the link to sign the owner out instead.
+	err = uc.ReportUnrecognizedLogin(ctx, dto.ReportUnrecognizedLoginRequest{Token: token, SessionID: current.TokenFamilyID.String()}, "", "")
+	require.ErrorIs(t, err, domainErr.ErrInvalidToken)
+	require.False(t, current.IsRevoked)
+
+	require.NoError(t, uc.ReportUnrecognizedLogin(ctx, dto.ReportUnrecognizedLoginRequest{Token: token, SessionID: reported.TokenFamilyID.String()}, "", ""))
+	require.True(t, reported.IsRevoked)
+	require.False(t, current.IsRevoked)
+}
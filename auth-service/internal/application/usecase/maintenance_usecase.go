@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/repository"
+)
+
+// MaintenanceUseCase purges rows that are no longer needed. Each method is
+// run periodically by the scheduler and returns the number of rows deleted.
+type MaintenanceUseCase struct {
+	refreshTokenRepo      repository.RefreshTokenRepository
+	tokenBlacklistRepo    repository.TokenBlacklistRepository
+	verificationTokenRepo repository.VerificationTokenRepository
+	auditLogRepo          repository.AuditLogRepository
+	authorizationCodeRepo repository.OAuthAuthorizationCodeRepository
+	throttleRepo          repository.ThrottleRepository
+	knownDeviceRepo       repository.KnownDeviceRepository
+	dataExportRepo        repository.DataExportRepository
+	emailChangeRepo       repository.EmailChangeRepository
+	config                MaintenanceConfig
+}
+
+// MaintenanceConfig holds how long rows are kept. Durations are counted from
+// expiry; audit logs are counted from creation.
+type MaintenanceConfig struct {
+	RefreshTokenRetention      time.Duration
+	TokenBlacklistRetention    time.Duration
+	VerificationTokenRetention time.Duration
+	AuditLogRetentionDays      int
+	// ThrottleEventRetention is the longest throttle window; older events
+	// are counted from when they happened.
+	ThrottleEventRetention time.Duration
+	// KnownDeviceRetention is counted from when the device was last seen.
+	KnownDeviceRetention time.Duration
+}
+
+func NewMaintenanceUseCase(
+	refreshTokenRepo repository.RefreshTokenRepository,
+	tokenBlacklistRepo repository.TokenBlacklistRepository,
+	verificationTokenRepo repository.VerificationTokenRepository,
+	auditLogRepo repository.AuditLogRepository,
+	authorizationCodeRepo repository.OAuthAuthorizationCodeRepository,
+	throttleRepo repository.ThrottleRepository,
+	knownDeviceRepo repository.KnownDeviceRepository,
+	dataExportRepo repository.DataExportRepository,
+	emailChangeRepo repository.EmailChangeRepository,
+	config MaintenanceConfig,
+) *MaintenanceUseCase {
+	return &MaintenanceUseCase{
+		refreshTokenRepo:      refreshTokenRepo,
+		tokenBlacklistRepo:    tokenBlacklistRepo,
+		verificationTokenRepo: verificationTokenRepo,
+		auditLogRepo:          auditLogRepo,
+		authorizationCodeRepo: authorizationCodeRepo,
+		throttleRepo:          throttleRepo,
+		knownDeviceRepo:       knownDeviceRepo,
+		dataExportRepo:        dataExportRepo,
+		emailChangeRepo:       emailChangeRepo,
+		config:                config,
+	}
+}
+
+// PurgeExpiredRefreshTokens deletes refresh tokens that expired more than
+// the retention ago. Revoked tokens are kept until they expire so replaying
+// them is still detected as reuse.
+func (uc *MaintenanceUseCase) PurgeExpiredRefreshTokens(ctx context.Context) (int64, error) {
+	return uc.refreshTokenRepo.DeleteExpired(ctx, time.Now().Add(-uc.config.RefreshTokenRetention))
+}
+
+// PurgeExpiredBlacklistEntries deletes blacklist entries of access tokens
+// that have expired and can no longer be used anyway.
+func (uc *MaintenanceUseCase) PurgeExpiredBlacklistEntries(ctx context.Context) (int64, error) {
+	return uc.tokenBlacklistRepo.DeleteExpired(ctx, time.Now().Add(-uc.config.TokenBlacklistRetention))
+}
+
+func (uc *MaintenanceUseCase) PurgeExpiredVerificationTokens(ctx context.Context) (int64, error) {
+	return uc.verificationTokenRepo.DeleteExpired(ctx, time.Now().Add(-uc.config.VerificationTokenRetention))
+}
+
+func (uc *MaintenanceUseCase) PurgeOldAuditLogs(ctx context.Context) (int64, error) {
+	return uc.auditLogRepo.DeleteOlderThan(ctx, uc.config.AuditLogRetentionDays)
+}
+
+// PurgeExpiredAuthorizationCodes deletes OAuth authorization codes once they
+// expire; an expired code is rejected whether or not it was used.
+func (uc *MaintenanceUseCase) PurgeExpiredAuthorizationCodes(ctx context.Context) (int64, error) {
+	return uc.authorizationCodeRepo.DeleteExpired(ctx, time.Now())
+}
+
+// PurgeOldThrottleEvents deletes throttle events that have left every
+// throttle window.
+func (uc *MaintenanceUseCase) PurgeOldThrottleEvents(ctx context.Context) (int64, error) {
+	return uc.throttleRepo.DeleteOlderThan(ctx, time.Now().Add(-uc.config.ThrottleEventRetention))
+}
+
+// PurgeStaleKnownDevices forgets devices that have not been used for the
+// retention period; a login from one is reported as new again.
+func (uc *MaintenanceUseCase) PurgeStaleKnownDevices(ctx context.Context) (int64, error) {
+	return uc.knownDeviceRepo.DeleteNotSeenSince(ctx, time.Now().Add(-uc.config.KnownDeviceRetention))
+}
+
+// PurgeExpiredDataExports deletes personal data exports, with their
+// archives, once they can no longer be downloaded.
+func (uc *MaintenanceUseCase) PurgeExpiredDataExports(ctx context.Context) (int64, error) {
+	return uc.dataExportRepo.DeleteExpired(ctx, time.Now())
+}
+
+// PurgeFinishedEmailChanges deletes email changes already synced to the
+// user-service, and unconfirmed ones that expired more than the verification
+// token retention ago.
+func (uc *MaintenanceUseCase) PurgeFinishedEmailChanges(ctx context.Context) (int64, error) {
+	return uc.emailChangeRepo.DeleteFinished(ctx, time.Now().Add(-uc.config.VerificationTokenRetention))
+}
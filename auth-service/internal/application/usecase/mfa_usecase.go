@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+const recoveryCodeCount = 10
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (uc *AuthUseCase) EnrollMFA(ctx context.Context, userID string) (*dto.MFAEnrollmentResponse, error) {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		return nil, domainErr.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	encrypted, err := uc.secretCipher.Encrypt(secret)
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	user.SetMFASecret(encrypted)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	return &dto.MFAEnrollmentResponse{
+		Secret:     secret,
+		OTPAuthURI: uc.totpService.ProvisioningURI(user.Email, secret),
+	}, nil
+}
+
+// ConfirmMFA activates MFA once the user proves their authenticator works and
+// returns the plain recovery codes. They are never retrievable again.
+func (uc *AuthUseCase) ConfirmMFA(ctx context.Context, userID, code, ipAddress, userAgent string) ([]string, error) {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		return nil, domainErr.ErrMFAAlreadyEnabled
+	}
+	if user.MFASecret == "" {
+		return nil, domainErr.ErrMFANotEnrolled
+	}
+
+	if err := uc.verifyTOTP(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	plainCodes, codes, err := uc.generateRecoveryCodes(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.recoveryCodeRepo.ReplaceAll(ctx, user.ID, codes); err != nil {
+		return nil, err
+	}
+
+	user.EnableMFA()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionMFAEnabled, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return plainCodes, nil
+}
+
+func (uc *AuthUseCase) DisableMFA(ctx context.Context, userID string, req dto.DisableMFARequest, ipAddress, userAgent string) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.MFAEnabled {
+		return domainErr.ErrMFANotEnabled
+	}
+
+	if err := uc.passwordService.VerifyPassword(user.PasswordHash, req.Password); err != nil {
+		return domainErr.ErrInvalidPassword
+	}
+
+	if _, err := uc.verifySecondFactor(ctx, user, req.Code); err != nil {
+		return err
+	}
+
+	user.DisableMFA()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return domainErr.ErrDatabase
+	}
+	_ = uc.recoveryCodeRepo.DeleteByUserID(ctx, user.ID)
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionMFADisabled, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// VerifyMFA trades an mfa_pending challenge token plus a TOTP or recovery code
+// for a real token pair. Wrong codes count as failed logins so the challenge
+// cannot be brute forced.
+func (uc *AuthUseCase) VerifyMFA(ctx context.Context, req dto.VerifyMFARequest, ipAddress, userAgent string) (*dto.AuthResponse, error) {
+	if req.MFAToken == "" {
+		return nil, domainErr.ErrMissingToken
+	}
+
+	challengeHash := uc.tokenService.HashToken(req.MFAToken)
+	challenge, err := uc.verificationTokenRepo.FindByTokenHash(ctx, challengeHash, entity.TokenPurposeMFAChallenge)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.IsValid() {
+		return nil, domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidToken
+	}
+
+	if !user.IsActive {
+		return nil, domainErr.ErrAccountInactive
+	}
+	if user.IsAccountLocked() {
+		return nil, domainErr.ErrAccountLocked
+	}
+
+	usedRecoveryCode, err := uc.verifySecondFactor(ctx, user, req.Code)
+	if err != nil {
+		user.IncrementFailedLoginAttempts(uc.config.MaxLoginAttempts, uc.config.AccountLockDuration)
+		_ = uc.userRepo.Update(ctx, user)
+
+		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionMFAChallengeFailed, ipAddress, userAgent)
+		_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+		return nil, err
+	}
+
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+
+	if usedRecoveryCode {
+		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionRecoveryCodeUsed, ipAddress, userAgent)
+		_ = uc.auditLogRepo.Create(ctx, auditLog)
+	}
+
+	return uc.completeLogin(ctx, user, ipAddress, userAgent)
+}
+
+func (uc *AuthUseCase) startMFAChallenge(ctx context.Context, user *entity.User) (*dto.AuthResponse, error) {
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposeMFAChallenge, uc.config.MFAChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		MFARequired: true,
+		MFAToken:    plain,
+	}, nil
+}
+
+// verifySecondFactor accepts either a 6-digit TOTP code or a recovery code and
+// reports whether a recovery code was consumed.
+func (uc *AuthUseCase) verifySecondFactor(ctx context.Context, user *entity.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if isNumeric(code) {
+		return false, uc.verifyTOTP(ctx, user, code)
+	}
+
+	codeHash := uc.tokenService.HashToken(normalizeRecoveryCode(code))
+	if err := uc.recoveryCodeRepo.Consume(ctx, user.ID, codeHash); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyTOTP checks code against the user's secret and marks its time step as
+// used, in the database before returning, so the code cannot be replayed
+// whatever the caller does next, and on the entity.
+func (uc *AuthUseCase) verifyTOTP(ctx context.Context, user *entity.User, code string) error {
+	secret, err := uc.secretCipher.Decrypt(user.MFASecret)
+	if err != nil {
+		return domainErr.ErrInternalServer
+	}
+
+	step, ok := uc.totpService.Validate(secret, code)
+	if !ok || step <= user.MFALastUsedStep {
+		return domainErr.ErrInvalidMFACode
+	}
+	if err := uc.userRepo.UseMFAStep(ctx, user.ID, step); err != nil {
+		return err
+	}
+	user.UseMFAStep(step)
+	return nil
+}
+
+func (uc *AuthUseCase) generateRecoveryCodes(userID uuid.UUID) ([]string, []*entity.RecoveryCode, error) {
+	plainCodes := make([]string, recoveryCodeCount)
+	codes := make([]*entity.RecoveryCode, recoveryCodeCount)
+	for i := range plainCodes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, domainErr.ErrInternalServer
+		}
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))[:10]
+		plainCodes[i] = raw[:5] + "-" + raw[5:]
+		codes[i] = entity.NewRecoveryCode(userID, uc.tokenService.HashToken(raw))
+	}
+	return plainCodes, codes, nil
+}
+
+func (uc *AuthUseCase) findUser(ctx context.Context, userID string) (*entity.User, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(code, "-", ""))
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixedTOTP accepts the code "123456" as belonging to step.
+type fixedTOTP struct {
+	step int64
+}
+
+func (fixedTOTP) GenerateSecret() (string, error)               { return "secret", nil }
+func (fixedTOTP) ProvisioningURI(account, secret string) string { return "" }
+
+func (t fixedTOTP) Validate(secret, code string) (int64, bool) {
+	return t.step, code == "123456"
+}
+
+type plainCipher struct{}
+
+func (plainCipher) Encrypt(plaintext string) (string, error)  { return plaintext, nil }
+func (plainCipher) Decrypt(ciphertext string) (string, error) { return ciphertext, nil }
+
+func newMFAUser() *entity.User {
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	user.SetMFASecret("secret")
+	user.EnableMFA()
+	return user
+}
+
+func TestTOTPCodeCannotBeReplayed(t *testing.T) {
+	ctx := context.Background()
+	user := newMFAUser()
+	uc := &AuthUseCase{userRepo: newMemoryUsers(user), totpService: fixedTOTP{step: 100}, secretCipher: plainCipher{}}
+
+	_, err := uc.verifySecondFactor(ctx, user, "123456")
+	require.NoError(t, err)
+
+	// A request that loaded the user before the code was used.
+	stale := newMFAUser()
+	stale.ID = user.ID
+	_, err = uc.verifySecondFactor(ctx, stale, "123456")
+	require.ErrorIs(t, err, domainErr.ErrInvalidMFACode)
+}
+
+func TestConcurrentTOTPReplayLetsOneSucceed(t *testing.T) {
+	ctx := context.Background()
+	user := newMFAUser()
+	users := newMemoryUsers(user)
+	uc := &AuthUseCase{userRepo: users, totpService: fixedTOTP{step: 100}, secretCipher: plainCipher{}}
+
+	const callers = 8
+	errs := make([]error, callers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range errs {
+		// Each request loads its own copy of the user before any of them
+		// has used the code.
+		loaded, err := users.FindByID(ctx, user.ID)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = uc.verifySecondFactor(ctx, loaded, "123456")
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, domainErr.ErrInvalidMFACode)
+	}
+	require.Equal(t, 1, succeeded)
+}
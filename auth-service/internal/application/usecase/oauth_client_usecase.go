@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// OAuth client registration is done by admins. The client ID is the
+// client's UUID.
+
+// CreateOAuthClient registers a client and returns its secret in plain text
+// if it is confidential. Only the hash is stored, so the secret cannot be
+// shown again. Machine clients always get a secret and take no redirect
+// URIs.
+func (uc *AuthUseCase) CreateOAuthClient(ctx context.Context, actorID string, req dto.CreateOAuthClientRequest, ipAddress, userAgent string) (*dto.CreatedOAuthClient, error) {
+	actor, err := uc.requireAdmin(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, domainErr.ErrInvalidInput
+	}
+	if req.Machine {
+		if len(req.RedirectURIs) > 0 {
+			return nil, domainErr.ErrInvalidInput
+		}
+	} else {
+		if len(req.RedirectURIs) == 0 {
+			return nil, domainErr.ErrInvalidInput
+		}
+		for _, uri := range req.RedirectURIs {
+			if !isValidRedirectURI(uri) {
+				return nil, domainErr.ErrInvalidRedirectURI
+			}
+		}
+	}
+
+	allowedScopes := entity.SupportedScopes
+	if req.Machine {
+		allowedScopes = entity.MachineScopes
+	}
+	scopes := entity.ParseScope(strings.Join(req.Scopes, " "))
+	if len(scopes) == 0 {
+		scopes = allowedScopes
+	}
+	if !entity.ContainsScopes(allowedScopes, scopes) {
+		return nil, domainErr.ErrInvalidScope
+	}
+
+	var secret, secretHash string
+	if req.Confidential || req.Machine {
+		secret, err = utils.GenerateRandomString(32)
+		if err != nil {
+			return nil, domainErr.ErrInternalServer
+		}
+		secretHash = uc.tokenService.HashToken(secret)
+	}
+
+	var client *entity.OAuthClient
+	if req.Machine {
+		client = entity.NewMachineClient(name, scopes, secretHash, actor.ID)
+	} else {
+		client = entity.NewOAuthClient(name, req.RedirectURIs, scopes, secretHash, req.FirstParty, actor.ID)
+	}
+	if err := uc.oauthClientRepo.Create(ctx, client); err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(actor.ID, entity.AuditActionOAuthClientCreated, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", client.ID.String())
+	auditLog.AddMetadata("client_name", client.Name)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return &dto.CreatedOAuthClient{
+		Client:       toOAuthClientDTO(client),
+		ClientSecret: secret,
+	}, nil
+}
+
+// ProvisionMachineClient registers the machine client in req, or brings its
+// name, secret and scopes up to date if it exists. It is run at startup for
+// the clients in OAUTH_MACHINE_CLIENTS and refuses to turn a client that
+// signs users in into a machine client.
+func (uc *AuthUseCase) ProvisionMachineClient(ctx context.Context, req dto.ProvisionMachineClientRequest) error {
+	id, err := uuid.Parse(req.ClientID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || req.Secret == "" {
+		return domainErr.ErrInvalidInput
+	}
+	scopes := entity.ParseScope(strings.Join(req.Scopes, " "))
+	if len(scopes) == 0 {
+		scopes = entity.MachineScopes
+	}
+	if !entity.ContainsScopes(entity.MachineScopes, scopes) {
+		return domainErr.ErrInvalidScope
+	}
+
+	existing, err := uc.oauthClientRepo.FindByID(ctx, id)
+	switch {
+	case err == nil:
+		if !existing.Machine {
+			return domainErr.ErrInvalidInput
+		}
+	case !errors.Is(err, domainErr.ErrOAuthClientNotFound):
+		return err
+	}
+
+	client := entity.NewMachineClient(name, scopes, uc.tokenService.HashToken(req.Secret), uuid.Nil)
+	client.ID = id
+	return uc.oauthClientRepo.Save(ctx, client)
+}
+
+func (uc *AuthUseCase) ListOAuthClients(ctx context.Context, actorID string) ([]*dto.OAuthClientDTO, error) {
+	if _, err := uc.requireAdmin(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	clients, err := uc.oauthClientRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.OAuthClientDTO, len(clients))
+	for i, client := range clients {
+		result[i] = toOAuthClientDTO(client)
+	}
+	return result, nil
+}
+
+// DeleteOAuthClient removes a client. Its refresh tokens stop working right
+// away because the client can no longer authenticate; access tokens already
+// issued to it stay valid until they expire, which for a machine client is
+// at most OAUTH_SERVICE_TOKEN_TTL.
+func (uc *AuthUseCase) DeleteOAuthClient(ctx context.Context, actorID, clientID, ipAddress, userAgent string) error {
+	actor, err := uc.requireAdmin(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(clientID)
+	if err != nil {
+		return domainErr.ErrOAuthClientNotFound
+	}
+	if err := uc.oauthClientRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(actor.ID, entity.AuditActionOAuthClientDeleted, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", id.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// isValidRedirectURI accepts absolute https URIs without a fragment, and
+// http only for loopback hosts used during development.
+func isValidRedirectURI(uri string) bool {
+	if strings.ContainsAny(uri, " \t\n") {
+		return false
+	}
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		return host == "localhost" || host == "127.0.0.1" || host == "::1"
+	default:
+		return false
+	}
+}
+
+func toOAuthClientDTO(client *entity.OAuthClient) *dto.OAuthClientDTO {
+	return &dto.OAuthClientDTO{
+		ClientID:     client.ID.String(),
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Confidential: client.IsConfidential(),
+		FirstParty:   client.FirstParty,
+		Machine:      client.Machine,
+		CreatedAt:    client.CreatedAt,
+	}
+}
@@ -0,0 +1,527 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/service"
+	"auth-service/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeRefreshToken      = "refresh_token"
+	grantTypeClientCredentials = "client_credentials"
+
+	codeChallengeMethodS256 = "S256"
+	// A S256 code challenge is an unpadded base64url SHA-256 digest.
+	codeChallengeLength = 43
+)
+
+// OAuth 2.0 authorization-code flow with PKCE (RFC 6749, RFC 7636) and
+// OpenID Connect ID tokens. The browser-facing authorization endpoint is a
+// page of the login app (OAUTH_AUTHORIZE_URL): it signs the user in as usual
+// and then calls Authorize with the user's access token and the query
+// parameters it received. Tokens issued to a client are ordinary access
+// tokens and refresh-token families, with the client and the granted scopes
+// recorded on them.
+//
+// Errors about the client or its redirect URI are returned to the login app,
+// which must not redirect; every other problem is reported to the client by
+// redirecting with an error parameter.
+
+func (uc *AuthUseCase) Authorize(ctx context.Context, userID string, req dto.AuthorizeRequest) (*dto.AuthorizeResponse, error) {
+	client, err := uc.findRedirectableClient(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.findAuthorizingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, errorCode := validateAuthorizeRequest(client, req)
+	if errorCode != "" {
+		return authorizeError(req, errorCode), nil
+	}
+
+	if !client.FirstParty {
+		consent, err := uc.oauthConsentRepo.Find(ctx, user.ID, client.ID)
+		if err != nil && !errors.Is(err, domainErr.ErrOAuthConsentNotFound) {
+			return nil, err
+		}
+		if consent == nil || !consent.Covers(scopes) {
+			return &dto.AuthorizeResponse{
+				ConsentRequired: true,
+				ClientName:      client.Name,
+				Scopes:          scopes,
+			}, nil
+		}
+	}
+
+	return uc.issueAuthorizationCode(ctx, client, user, scopes, req)
+}
+
+// GrantConsent records the user's answer on the consent screen. The login
+// app sends back the same parameters it passed to Authorize.
+func (uc *AuthUseCase) GrantConsent(ctx context.Context, userID string, req dto.AuthorizeRequest, approved bool, ipAddress, userAgent string) (*dto.AuthorizeResponse, error) {
+	client, err := uc.findRedirectableClient(ctx, req.ClientID, req.RedirectURI)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.findAuthorizingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, errorCode := validateAuthorizeRequest(client, req)
+	if errorCode != "" {
+		return authorizeError(req, errorCode), nil
+	}
+	if !approved {
+		return authorizeError(req, "access_denied"), nil
+	}
+
+	consent, err := uc.oauthConsentRepo.Find(ctx, user.ID, client.ID)
+	if err != nil {
+		if !errors.Is(err, domainErr.ErrOAuthConsentNotFound) {
+			return nil, err
+		}
+		consent = entity.NewOAuthConsent(user.ID, client.ID, scopes)
+	} else {
+		consent.Grant(scopes)
+	}
+	if err := uc.oauthConsentRepo.Save(ctx, consent); err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionOAuthConsentGranted, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", client.ID.String())
+	auditLog.AddMetadata("scope", entity.FormatScope(scopes))
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return uc.issueAuthorizationCode(ctx, client, user, scopes, req)
+}
+
+// OAuthToken implements the token endpoint for the authorization_code and
+// refresh_token grants, and for the client_credentials grant used by machine
+// clients. Machine clients cannot use the other grants, nor user-facing
+// clients the client_credentials grant.
+func (uc *AuthUseCase) OAuthToken(ctx context.Context, req dto.OAuthTokenRequest, ipAddress, userAgent string) (*dto.OAuthTokenResponse, error) {
+	switch req.GrantType {
+	case grantTypeAuthorizationCode, grantTypeRefreshToken, grantTypeClientCredentials:
+	default:
+		return nil, domainErr.ErrUnsupportedGrantType
+	}
+
+	client, err := uc.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if client.Machine != (req.GrantType == grantTypeClientCredentials) {
+		return nil, domainErr.ErrUnauthorizedClient
+	}
+
+	switch req.GrantType {
+	case grantTypeAuthorizationCode:
+		return uc.exchangeAuthorizationCode(ctx, client, req, ipAddress, userAgent)
+	case grantTypeRefreshToken:
+		return uc.refreshClientToken(ctx, client, req.RefreshToken, ipAddress, userAgent)
+	default:
+		return uc.issueServiceToken(client, req.Scope)
+	}
+}
+
+// issueServiceToken implements the client_credentials grant (RFC 6749
+// section 4.4). The token is short-lived and comes without a refresh token;
+// the client requests a new one before it expires. Without a scope parameter
+// every scope registered for the client is granted.
+func (uc *AuthUseCase) issueServiceToken(client *entity.OAuthClient, scope string) (*dto.OAuthTokenResponse, error) {
+	scopes := entity.ParseScope(scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	if !client.AllowsScopes(scopes) {
+		return nil, domainErr.ErrInvalidScope
+	}
+
+	granted := entity.FormatScope(scopes)
+	accessToken, err := uc.tokenService.GenerateServiceToken(service.TokenClaims{
+		Role:     entity.MachineClientRole,
+		ClientID: client.ID.String(),
+		Scope:    granted,
+	}, uc.config.ServiceTokenTTL)
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	return &dto.OAuthTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(uc.config.ServiceTokenTTL.Seconds()),
+		Scope:       granted,
+	}, nil
+}
+
+func (uc *AuthUseCase) exchangeAuthorizationCode(ctx context.Context, client *entity.OAuthClient, req dto.OAuthTokenRequest, ipAddress, userAgent string) (*dto.OAuthTokenResponse, error) {
+	if req.Code == "" {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	code, err := uc.authorizationCodeRepo.FindByCodeHash(ctx, uc.tokenService.HashToken(req.Code))
+	if err != nil {
+		return nil, err
+	}
+	if code.ClientID != client.ID {
+		return nil, domainErr.ErrInvalidGrant
+	}
+	if code.IsUsed() {
+		return nil, uc.handleAuthorizationCodeReuse(ctx, code, ipAddress, userAgent)
+	}
+	if !code.IsValid() || code.RedirectURI != req.RedirectURI || !code.VerifyPKCE(req.CodeVerifier) {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, code.UserID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidGrant
+	}
+	if !user.IsActive || user.IsAccountLocked() {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	refreshPlain, refreshHash, err := uc.tokenService.GenerateRefreshToken()
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	expiresAt := time.Now().Add(uc.tokenService.GetRefreshTokenExpiry())
+	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, expiresAt, ipAddress, userAgent)
+	refreshToken.ClientID = &client.ID
+	refreshToken.Scope = entity.FormatScope(code.Scopes)
+
+	// Consuming the code fails if another request exchanged it first.
+	if err := uc.authorizationCodeRepo.MarkUsed(ctx, code.ID, refreshToken.TokenFamilyID); err != nil {
+		return nil, err
+	}
+	if err := uc.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	response, err := uc.clientTokenResponse(user, client, refreshToken, refreshPlain, code.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLogin, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", client.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return response, nil
+}
+
+// refreshClientToken rotates a refresh token the same way RefreshToken does,
+// but only for tokens issued to the authenticated client.
+func (uc *AuthUseCase) refreshClientToken(ctx context.Context, client *entity.OAuthClient, refreshPlain, ipAddress, userAgent string) (*dto.OAuthTokenResponse, error) {
+	if refreshPlain == "" {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	token, err := uc.refreshTokenRepo.FindByTokenHash(ctx, uc.tokenService.HashToken(refreshPlain))
+	if err != nil {
+		return nil, domainErr.ErrInvalidGrant
+	}
+	if !token.IssuedToClient(client.ID) {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	user, newRefreshToken, newRefreshPlain, err := uc.rotateRefreshToken(ctx, token, ipAddress, userAgent)
+	if err != nil {
+		switch err {
+		case domainErr.ErrInvalidToken, domainErr.ErrTokenRevoked, domainErr.ErrTokenReuseDetected,
+			domainErr.ErrUserNotFound, domainErr.ErrAccountInactive:
+			return nil, domainErr.ErrInvalidGrant
+		}
+		return nil, err
+	}
+
+	response, err := uc.clientTokenResponse(user, client, newRefreshToken, newRefreshPlain, "")
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionTokenRefresh, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", client.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return response, nil
+}
+
+// clientTokenResponse issues the access token, and the ID token if openid
+// was granted, for a refresh token that belongs to client.
+func (uc *AuthUseCase) clientTokenResponse(user *entity.User, client *entity.OAuthClient, refreshToken *entity.RefreshToken, refreshPlain, nonce string) (*dto.OAuthTokenResponse, error) {
+	accessToken, err := uc.tokenService.GenerateAccessToken(service.TokenClaims{
+		UserID:    user.ID.String(),
+		Email:     user.Email,
+		Role:      string(uc.effectiveRole(user)),
+		SessionID: refreshToken.TokenFamilyID.String(),
+		ClientID:  client.ID.String(),
+		Scope:     refreshToken.Scope,
+	})
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	response := &dto.OAuthTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(uc.tokenService.GetAccessTokenExpiry().Seconds()),
+		RefreshToken: refreshPlain,
+		Scope:        refreshToken.Scope,
+	}
+
+	scopes := entity.ParseScope(refreshToken.Scope)
+	if entity.HasScope(scopes, entity.ScopeOpenID) {
+		claims := service.IDTokenClaims{
+			Subject:  user.ID.String(),
+			Audience: client.ID.String(),
+			Nonce:    nonce,
+		}
+		if entity.HasScope(scopes, entity.ScopeEmail) {
+			claims.Email = user.Email
+			claims.EmailVerified = user.IsVerified
+		}
+		response.IDToken, err = uc.tokenService.GenerateIDToken(claims)
+		if err != nil {
+			return nil, domainErr.ErrInternalServer
+		}
+	}
+
+	return response, nil
+}
+
+// handleAuthorizationCodeReuse is called when a code is exchanged a second
+// time. The code has leaked, so the session it started is revoked
+// (RFC 6749 section 4.1.2).
+func (uc *AuthUseCase) handleAuthorizationCodeReuse(ctx context.Context, code *entity.OAuthAuthorizationCode, ipAddress, userAgent string) error {
+	if code.SessionID != nil {
+		if err := uc.refreshTokenRepo.RevokeByTokenFamilyID(ctx, *code.SessionID); err != nil {
+			return domainErr.ErrDatabase
+		}
+	}
+
+	auditLog := entity.NewAuditLog(code.UserID, entity.AuditActionTokenReuseDetected, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", code.ClientID.String())
+	auditLog.AddMetadata("authorization_code_id", code.ID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return domainErr.ErrInvalidGrant
+}
+
+func (uc *AuthUseCase) issueAuthorizationCode(ctx context.Context, client *entity.OAuthClient, user *entity.User, scopes []string, req dto.AuthorizeRequest) (*dto.AuthorizeResponse, error) {
+	plain, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, domainErr.ErrInternalServer
+	}
+
+	code := entity.NewOAuthAuthorizationCode(
+		uc.tokenService.HashToken(plain),
+		client.ID,
+		user.ID,
+		req.RedirectURI,
+		scopes,
+		req.CodeChallenge,
+		req.Nonce,
+		time.Now().Add(uc.config.AuthorizationCodeTTL),
+	)
+	if err := uc.authorizationCodeRepo.Create(ctx, code); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{"code": {plain}}
+	if req.State != "" {
+		params.Set("state", req.State)
+	}
+	return &dto.AuthorizeResponse{RedirectTo: redirectURL(req.RedirectURI, params)}, nil
+}
+
+// findRedirectableClient loads the client of an authorization request and
+// checks that redirect_uri is registered for it.
+func (uc *AuthUseCase) findRedirectableClient(ctx context.Context, clientID, redirectURI string) (*entity.OAuthClient, error) {
+	id, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, domainErr.ErrOAuthClientNotFound
+	}
+	client, err := uc.oauthClientRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !client.HasRedirectURI(redirectURI) {
+		return nil, domainErr.ErrInvalidRedirectURI
+	}
+	return client, nil
+}
+
+func (uc *AuthUseCase) findAuthorizingUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, domainErr.ErrAccountInactive
+	}
+	if user.IsAccountLocked() {
+		return nil, domainErr.ErrAccountLocked
+	}
+	return user, nil
+}
+
+// authenticateClient identifies the client at the token endpoint. Public
+// clients only send their ID; confidential clients must also send their
+// secret.
+func (uc *AuthUseCase) authenticateClient(ctx context.Context, clientID, clientSecret string) (*entity.OAuthClient, error) {
+	id, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidClient
+	}
+	client, err := uc.oauthClientRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainErr.ErrOAuthClientNotFound) {
+			return nil, domainErr.ErrInvalidClient
+		}
+		return nil, err
+	}
+
+	if client.IsConfidential() {
+		secretHash := uc.tokenService.HashToken(clientSecret)
+		if clientSecret == "" || subtle.ConstantTimeCompare([]byte(secretHash), []byte(client.SecretHash)) != 1 {
+			return nil, domainErr.ErrInvalidClient
+		}
+	}
+	return client, nil
+}
+
+// validateAuthorizeRequest returns the requested scopes, or the OAuth error
+// code to redirect with.
+func validateAuthorizeRequest(client *entity.OAuthClient, req dto.AuthorizeRequest) ([]string, string) {
+	if req.ResponseType != "code" {
+		return nil, "unsupported_response_type"
+	}
+	// PKCE is required for every client, and only with S256.
+	if req.CodeChallengeMethod != codeChallengeMethodS256 || len(req.CodeChallenge) != codeChallengeLength {
+		return nil, "invalid_request"
+	}
+
+	scopes := entity.ParseScope(req.Scope)
+	if len(scopes) == 0 || !client.AllowsScopes(scopes) {
+		return nil, "invalid_scope"
+	}
+	return scopes, ""
+}
+
+func authorizeError(req dto.AuthorizeRequest, errorCode string) *dto.AuthorizeResponse {
+	params := url.Values{"error": {errorCode}}
+	if req.State != "" {
+		params.Set("state", req.State)
+	}
+	return &dto.AuthorizeResponse{RedirectTo: redirectURL(req.RedirectURI, params)}
+}
+
+// redirectURL adds params to the query of a registered redirect URI.
+func redirectURL(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (uc *AuthUseCase) ListOAuthConsents(ctx context.Context, userID string) ([]*dto.OAuthConsentDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	consents, err := uc.oauthConsentRepo.ListByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.OAuthConsentDTO, 0, len(consents))
+	for _, consent := range consents {
+		client, err := uc.oauthClientRepo.FindByID(ctx, consent.ClientID)
+		if err != nil {
+			// The client was deleted; its consent no longer matters.
+			if errors.Is(err, domainErr.ErrOAuthClientNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, &dto.OAuthConsentDTO{
+			ClientID:   client.ID.String(),
+			ClientName: client.Name,
+			Scopes:     consent.Scopes,
+			GrantedAt:  consent.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+// RevokeOAuthConsent withdraws the user's consent for a client and ends the
+// sessions the client holds for them.
+func (uc *AuthUseCase) RevokeOAuthConsent(ctx context.Context, userID, clientID, ipAddress, userAgent string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+	clientUUID, err := uuid.Parse(clientID)
+	if err != nil {
+		return domainErr.ErrOAuthConsentNotFound
+	}
+
+	if err := uc.oauthConsentRepo.Delete(ctx, userUUID, clientUUID); err != nil {
+		return err
+	}
+	if err := uc.refreshTokenRepo.RevokeByClientID(ctx, userUUID, clientUUID); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionOAuthConsentRevoked, ipAddress, userAgent)
+	auditLog.AddMetadata("client_id", clientUUID.String())
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// GetOpenIDConfiguration returns the OpenID Connect discovery document.
+func (uc *AuthUseCase) GetOpenIDConfiguration() *dto.OpenIDConfiguration {
+	baseURL := strings.TrimSuffix(uc.config.OIDCIssuer, "/")
+	return &dto.OpenIDConfiguration{
+		Issuer:                            uc.config.OIDCIssuer,
+		AuthorizationEndpoint:             uc.config.OAuthAuthorizeURL,
+		TokenEndpoint:                     baseURL + "/api/v1/auth/oauth/token",
+		JWKSURI:                           baseURL + "/.well-known/jwks.json",
+		ScopesSupported:                   entity.SupportedScopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{grantTypeAuthorizationCode, grantTypeRefreshToken, grantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{uc.tokenService.GetAlgorithm()},
+		TokenEndpointAuthMethodsSupported: []string{"none", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{codeChallengeMethodS256},
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "iat", "nonce", "email", "email_verified"},
+	}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+)
+
+// validateNewPassword applies the password policy and rejects the current
+// password and the last PasswordHistorySize ones.
+func (uc *AuthUseCase) validateNewPassword(ctx context.Context, user *entity.User, password string) error {
+	if err := uc.passwordPolicy.Validate(password, user.Email); err != nil {
+		return err
+	}
+
+	reused := uc.passwordService.VerifyPassword(user.PasswordHash, password) == nil
+	if !reused && uc.config.PasswordHistorySize > 0 {
+		history, err := uc.passwordHistoryRepo.ListRecent(ctx, user.ID, uc.config.PasswordHistorySize)
+		if err != nil {
+			return err
+		}
+		for _, entry := range history {
+			if uc.passwordService.VerifyPassword(entry.PasswordHash, password) == nil {
+				reused = true
+				break
+			}
+		}
+	}
+
+	if reused {
+		return &domainErr.PasswordPolicyError{Violations: []domainErr.PasswordViolation{{
+			Rule:    domainErr.PasswordRuleReused,
+			Message: "password must not be one of your recent passwords",
+		}}}
+	}
+	return nil
+}
+
+// replacePassword moves the current hash to the password history and sets a
+// hash of newPassword on user; the caller persists the user.
+func (uc *AuthUseCase) replacePassword(ctx context.Context, user *entity.User, newPassword string) error {
+	passwordHash, err := uc.passwordService.HashPassword(newPassword)
+	if err != nil {
+		return domainErr.ErrInternalServer
+	}
+
+	if uc.config.PasswordHistorySize > 0 {
+		if err := uc.passwordHistoryRepo.Create(ctx, entity.NewPasswordHistory(user.ID, user.PasswordHash)); err != nil {
+			return err
+		}
+		if err := uc.passwordHistoryRepo.Prune(ctx, user.ID, uc.config.PasswordHistorySize); err != nil {
+			return err
+		}
+	}
+
+	user.UpdatePassword(passwordHash)
+	return nil
+}
+
+// startPasswordChange ends a login whose password is older than
+// PasswordMaxAge without tokens. The returned token lets the user set a new
+// password with ChangeExpiredPassword, which then completes the login.
+func (uc *AuthUseCase) startPasswordChange(ctx context.Context, user *entity.User) (*dto.AuthResponse, error) {
+	if err := uc.verificationTokenRepo.InvalidateByUserID(ctx, user.ID, entity.TokenPurposePasswordChange); err != nil {
+		return nil, err
+	}
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposePasswordChange, uc.config.PasswordChangeTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		PasswordExpired:     true,
+		PasswordChangeToken: plain,
+	}, nil
+}
+
+// ChangeExpiredPassword trades a password_change token and a new password for
+// a token pair. Like ChangePassword, it ends the user's other sessions.
+func (uc *AuthUseCase) ChangeExpiredPassword(ctx context.Context, req dto.ChangeExpiredPasswordRequest, ipAddress, userAgent string) (*dto.AuthResponse, error) {
+	if req.PasswordChangeToken == "" {
+		return nil, domainErr.ErrMissingToken
+	}
+
+	tokenHash := uc.tokenService.HashToken(req.PasswordChangeToken)
+	change, err := uc.verificationTokenRepo.FindByTokenHash(ctx, tokenHash, entity.TokenPurposePasswordChange)
+	if err != nil {
+		return nil, err
+	}
+	if !change.IsValid() {
+		return nil, domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, change.UserID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidToken
+	}
+	if !user.IsActive {
+		return nil, domainErr.ErrAccountInactive
+	}
+	if user.IsAccountLocked() {
+		return nil, domainErr.ErrAccountLocked
+	}
+
+	// As with a reset, a rejected password does not use up the token.
+	if err := uc.validateNewPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, change.ID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.replacePassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionPasswordChange, ipAddress, userAgent)
+	auditLog.AddMetadata("reason", "expired")
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return uc.completeLogin(ctx, user, ipAddress, userAgent)
+}
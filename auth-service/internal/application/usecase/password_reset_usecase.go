@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/service"
+)
+
+// RequestPasswordReset returns nil whether or not the email is registered so
+// callers cannot use it to enumerate accounts. Delivery errors are swallowed
+// for the same reason.
+func (uc *AuthUseCase) RequestPasswordReset(ctx context.Context, email, ipAddress, userAgent string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == domainErr.ErrUserNotFound {
+			return nil
+		}
+		return domainErr.ErrDatabase
+	}
+
+	if !user.IsActive {
+		return nil
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionPasswordResetRequested, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	_ = uc.sendPasswordResetEmail(ctx, user)
+
+	return nil
+}
+
+func (uc *AuthUseCase) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest, ipAddress, userAgent string) error {
+	if req.Token == "" {
+		return domainErr.ErrMissingToken
+	}
+
+	tokenHash := uc.tokenService.HashToken(req.Token)
+	reset, err := uc.verificationTokenRepo.FindByTokenHash(ctx, tokenHash, entity.TokenPurposePasswordReset)
+	if err != nil {
+		return err
+	}
+
+	if !reset.IsValid() {
+		return domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, reset.UserID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+
+	// Check the new password before consuming the token so a weak password
+	// does not force the user to request another email.
+	if err := uc.validateNewPassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, reset.ID); err != nil {
+		return err
+	}
+
+	if err := uc.replacePassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+	user.ResetFailedLoginAttempts()
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionPasswordReset, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+func (uc *AuthUseCase) sendPasswordResetEmail(ctx context.Context, user *entity.User) error {
+	if err := uc.verificationTokenRepo.InvalidateByUserID(ctx, user.ID, entity.TokenPurposePasswordReset); err != nil {
+		return err
+	}
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposePasswordReset, uc.config.PasswordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", uc.config.AppBaseURL, url.QueryEscape(plain))
+	return uc.mailSender.Send(ctx, service.MailMessage{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"We received a request to reset your password.\n\nOpen the link below to choose a new one:\n\n%s\n\nThe link expires in %s and can be used once. If you did not request this, you can ignore this email.\n",
+			link, uc.config.PasswordResetTokenTTL,
+		),
+	})
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type passwordResetFixture struct {
+	uc       *AuthUseCase
+	user     *entity.User
+	users    *memoryUsers
+	tokens   *memoryVerificationTokens
+	sessions *memoryRefreshTokens
+	mail     *capturedMail
+}
+
+func newPasswordResetFixture() *passwordResetFixture {
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	f := &passwordResetFixture{
+		user:     user,
+		users:    newMemoryUsers(user),
+		tokens:   newMemoryVerificationTokens(),
+		sessions: &memoryRefreshTokens{},
+		mail:     &capturedMail{},
+	}
+	f.uc = &AuthUseCase{
+		userRepo:              f.users,
+		verificationTokenRepo: f.tokens,
+		refreshTokenRepo:      f.sessions,
+		auditLogRepo:          discardAuditLogs{},
+		tokenService:          hashingTokens{},
+		passwordService:       plainPasswords{},
+		passwordPolicy:        acceptAllPasswords{},
+		mailSender:            f.mail,
+		config: AuthConfig{
+			PasswordResetTokenTTL: time.Hour,
+			AppBaseURL:            "https://shop.example.com",
+		},
+	}
+	return f
+}
+
+var resetLinkPattern = regexp.MustCompile(`/reset-password\?token=(\S+)`)
+
+// requestReset asks for a reset email and returns the token from its link.
+func (f *passwordResetFixture) requestReset(t *testing.T) string {
+	t.Helper()
+	sent := len(f.mail.messages)
+	require.NoError(t, f.uc.RequestPasswordReset(context.Background(), f.user.Email, "", ""))
+	require.Len(t, f.mail.messages, sent+1)
+
+	msg := f.mail.messages[sent]
+	require.Equal(t, f.user.Email, msg.To)
+	match := resetLinkPattern.FindStringSubmatch(msg.Body)
+	require.NotNil(t, match)
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+	return token
+}
+
+func (f *passwordResetFixture) reset(token, password string) error {
+	return f.uc.ResetPassword(context.Background(), dto.ResetPasswordRequest{Token: token, NewPassword: password}, "", "")
+}
+
+func (f *passwordResetFixture) storedPasswordHash(t *testing.T) string {
+	t.Helper()
+	user, err := f.users.FindByID(context.Background(), f.user.ID)
+	require.NoError(t, err)
+	return user.PasswordHash
+}
+
+func TestPasswordResetSetsPasswordAndEndsSessions(t *testing.T) {
+	f := newPasswordResetFixture()
+
+	token := f.requestReset(t)
+	require.NoError(t, f.reset(token, "Lantern-Meadow-77"))
+
+	require.Equal(t, "hashed:Lantern-Meadow-77", f.storedPasswordHash(t))
+	require.Equal(t, []uuid.UUID{f.user.ID}, f.sessions.revokedUsers)
+}
+
+func TestPasswordResetTokenWorksOnce(t *testing.T) {
+	f := newPasswordResetFixture()
+
+	token := f.requestReset(t)
+	require.NoError(t, f.reset(token, "Lantern-Meadow-77"))
+
+	require.ErrorIs(t, f.reset(token, "Copper-Harbor-19"), domainErr.ErrInvalidToken)
+	require.Equal(t, "hashed:Lantern-Meadow-77", f.storedPasswordHash(t))
+}
+
+func TestPasswordResetTokenExpires(t *testing.T) {
+	f := newPasswordResetFixture()
+
+	token := f.requestReset(t)
+	f.tokens.expire()
+
+	require.ErrorIs(t, f.reset(token, "Lantern-Meadow-77"), domainErr.ErrInvalidToken)
+	require.Equal(t, "hashed:Tangerine-Orbit-42", f.storedPasswordHash(t))
+	require.Empty(t, f.sessions.revokedUsers)
+}
+
+func TestNewPasswordResetRequestInvalidatesEarlierToken(t *testing.T) {
+	f := newPasswordResetFixture()
+
+	first := f.requestReset(t)
+	second := f.requestReset(t)
+
+	require.ErrorIs(t, f.reset(first, "Lantern-Meadow-77"), domainErr.ErrInvalidToken)
+	require.NoError(t, f.reset(second, "Lantern-Meadow-77"))
+}
+
+func TestPasswordResetForUnknownEmailSendsNothing(t *testing.T) {
+	f := newPasswordResetFixture()
+
+	require.NoError(t, f.uc.RequestPasswordReset(context.Background(), "nobody@example.com", "", ""))
+	require.Empty(t, f.mail.messages)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/application/dto"
+)
+
+const revokedTokenPageSize = 1000
+
+// ListRevokedTokens is the revocation feed other services poll to keep their
+// blacklist caches current. Pass NextSince back as since; while HasMore is
+// set, the next page is available right away.
+func (uc *AuthUseCase) ListRevokedTokens(ctx context.Context, since time.Time) (*dto.RevokedTokenPage, error) {
+	now := time.Now()
+	entries, err := uc.tokenBlacklistRepo.FindCreatedSince(ctx, since, revokedTokenPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &dto.RevokedTokenPage{
+		Tokens:    make([]*dto.RevokedTokenDTO, len(entries)),
+		NextSince: now,
+	}
+	for i, entry := range entries {
+		page.Tokens[i] = &dto.RevokedTokenDTO{
+			TokenHash: entry.TokenHash,
+			ExpiresAt: entry.ExpiresAt,
+		}
+	}
+	if len(entries) == revokedTokenPageSize {
+		page.NextSince = entries[len(entries)-1].CreatedAt
+		page.HasMore = true
+	}
+
+	return page, nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// A session is a refresh-token family: it starts at login and survives
+// rotation. Its ID is the family ID, which is also the sid claim of every
+// access token issued for it.
+
+func (uc *AuthUseCase) ListSessions(ctx context.Context, userID, currentSessionID string) ([]*dto.SessionDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	sessions, err := uc.activeSessions(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.SessionDTO, 0, len(sessions))
+	for familyID, token := range sessions {
+		result = append(result, &dto.SessionDTO{
+			ID:         familyID.String(),
+			IPAddress:  token.IPAddress,
+			UserAgent:  token.UserAgent,
+			CreatedAt:  token.SessionStartedAt,
+			LastUsedAt: token.CreatedAt,
+			Current:    familyID.String() == currentSessionID,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastUsedAt.After(result[j].LastUsedAt)
+	})
+
+	return result, nil
+}
+
+func (uc *AuthUseCase) RevokeSession(ctx context.Context, userID, sessionID, ipAddress, userAgent string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+	familyID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return domainErr.ErrSessionNotFound
+	}
+
+	sessions, err := uc.activeSessions(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+	if _, ok := sessions[familyID]; !ok {
+		return domainErr.ErrSessionNotFound
+	}
+
+	if err := uc.refreshTokenRepo.RevokeByTokenFamilyID(ctx, familyID); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionSessionRevoked, ipAddress, userAgent)
+	auditLog.AddMetadata("session_id", sessionID)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+func (uc *AuthUseCase) revokeOtherSessions(ctx context.Context, userID uuid.UUID, keepSessionID, ipAddress, userAgent string) error {
+	sessions, err := uc.activeSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	revoked := 0
+	for familyID := range sessions {
+		if familyID.String() == keepSessionID {
+			continue
+		}
+		if err := uc.refreshTokenRepo.RevokeByTokenFamilyID(ctx, familyID); err != nil {
+			return domainErr.ErrDatabase
+		}
+		revoked++
+	}
+
+	auditLog := entity.NewAuditLog(userID, entity.AuditActionLogout, ipAddress, userAgent)
+	auditLog.AddMetadata("logout_all", true)
+	auditLog.AddMetadata("kept_session_id", keepSessionID)
+	auditLog.AddMetadata("revoked_sessions", revoked)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// activeSessions returns the newest valid refresh token of each session.
+func (uc *AuthUseCase) activeSessions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]*entity.RefreshToken, error) {
+	tokens, err := uc.refreshTokenRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	sessions := make(map[uuid.UUID]*entity.RefreshToken)
+	for _, token := range tokens {
+		if existing, ok := sessions[token.TokenFamilyID]; ok && existing.CreatedAt.After(token.CreatedAt) {
+			continue
+		}
+		sessions[token.TokenFamilyID] = token
+	}
+	return sessions, nil
+}
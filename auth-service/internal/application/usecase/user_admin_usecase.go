@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+)
+
+// Account management for admins. Every action is recorded in the audit log of
+// the affected user with the acting admin in the actor_id and actor_email
+// metadata. Actions that take access away also revoke the user's refresh
+// tokens, so existing sessions end once their access tokens expire.
+
+func (uc *AuthUseCase) ListUsers(ctx context.Context, actorID string, req dto.ListUsersRequest) (*dto.UserPage, error) {
+	if _, err := uc.requireAdmin(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	filter := repository.UserFilter{
+		Query:    req.Query,
+		IsActive: req.IsActive,
+		Locked:   req.Locked,
+	}
+	if req.Role != "" {
+		role := entity.Role(req.Role)
+		if !role.IsValid() {
+			return nil, domainErr.ErrInvalidInput
+		}
+		filter.Role = &role
+	}
+
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultUserPageSize
+	}
+	if pageSize > maxUserPageSize {
+		pageSize = maxUserPageSize
+	}
+
+	users, total, err := uc.userRepo.List(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.AdminUserDTO, len(users))
+	for i, user := range users {
+		result[i] = toAdminUserDTO(user)
+	}
+
+	return &dto.UserPage{
+		Users:    result,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+func (uc *AuthUseCase) GetUser(ctx context.Context, actorID, userID string) (*dto.AdminUserDTO, error) {
+	if _, err := uc.requireAdmin(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAdminUserDTO(user), nil
+}
+
+// LockUser locks the account for duration (a Go duration string), or for
+// ACCOUNT_LOCK_DURATION if duration is empty.
+func (uc *AuthUseCase) LockUser(ctx context.Context, actorID, userID, duration, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	lockDuration := uc.config.AccountLockDuration
+	if duration != "" {
+		parsed, err := time.ParseDuration(duration)
+		if err != nil || parsed <= 0 {
+			return nil, domainErr.ErrInvalidInput
+		}
+		lockDuration = parsed
+	}
+
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Lock(time.Now().Add(lockDuration))
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionUserLocked, reason, ipAddress, userAgent)
+	auditLog.AddMetadata("locked_until", user.LockedUntil.Format(time.RFC3339))
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+func (uc *AuthUseCase) UnlockUser(ctx context.Context, actorID, userID, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Unlock()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionUserUnlocked, reason, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+func (uc *AuthUseCase) ActivateUser(ctx context.Context, actorID, userID, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Activate()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionUserActivated, reason, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+func (uc *AuthUseCase) DeactivateUser(ctx context.Context, actorID, userID, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Deactivate()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionUserDeactivated, reason, ipAddress, userAgent)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+// ChangeUserRole revokes the user's refresh tokens because the role is a
+// claim of every access token; the user gets the new role at the next login.
+func (uc *AuthUseCase) ChangeUserRole(ctx context.Context, actorID, userID, role, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	newRole := entity.Role(role)
+	if !newRole.IsValid() {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == newRole {
+		return toAdminUserDTO(user), nil
+	}
+
+	previousRole := user.Role
+	user.ChangeRole(newRole)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionUserRoleChanged, reason, ipAddress, userAgent)
+	auditLog.AddMetadata("previous_role", string(previousRole))
+	auditLog.AddMetadata("role", string(newRole))
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+// ForcePasswordReset blocks login until the user sets a new password through
+// the reset email, which is sent right away.
+func (uc *AuthUseCase) ForcePasswordReset(ctx context.Context, actorID, userID, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error) {
+	actor, user, err := uc.adminTarget(ctx, actorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.RequirePasswordReset()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	// The flag is already set; if delivery fails the user can still request
+	// another email through the forgot-password flow.
+	emailErr := uc.sendPasswordResetEmail(ctx, user)
+
+	auditLog := newAdminAuditLog(actor, user, entity.AuditActionPasswordResetForced, reason, ipAddress, userAgent)
+	auditLog.AddMetadata("email_sent", emailErr == nil)
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return toAdminUserDTO(user), nil
+}
+
+// adminTarget checks that the actor is an admin and loads the user they act
+// on. Admins cannot act on their own account, so they cannot lock themselves
+// out or give up their own role by accident.
+func (uc *AuthUseCase) adminTarget(ctx context.Context, actorID, userID string) (*entity.User, *entity.User, error) {
+	actor, err := uc.requireAdmin(ctx, actorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, nil, domainErr.ErrUserNotFound
+	}
+	if targetID == actor.ID {
+		return nil, nil, domainErr.ErrCannotModifySelf
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor, user, nil
+}
+
+func newAdminAuditLog(actor, user *entity.User, action entity.AuditAction, reason, ipAddress, userAgent string) *entity.AuditLog {
+	auditLog := entity.NewAuditLog(user.ID, action, ipAddress, userAgent)
+	auditLog.AddMetadata("actor_id", actor.ID.String())
+	auditLog.AddMetadata("actor_email", actor.Email)
+	if reason != "" {
+		auditLog.AddMetadata("reason", reason)
+	}
+	return auditLog
+}
+
+func toAdminUserDTO(user *entity.User) *dto.AdminUserDTO {
+	result := &dto.AdminUserDTO{
+		ID:                    user.ID.String(),
+		Email:                 user.Email,
+		Role:                  string(user.Role),
+		IsVerified:            user.IsVerified,
+		IsActive:              user.IsActive,
+		MFAEnabled:            user.MFAEnabled,
+		IsLocked:              user.IsAccountLocked(),
+		FailedLoginAttempts:   user.FailedLoginAttempts,
+		PasswordResetRequired: user.PasswordResetRequired,
+		LastLoginAt:           user.LastLoginAt,
+		LastLoginIP:           user.LastLoginIP,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+	}
+	if result.IsLocked {
+		result.LockedUntil = user.LockedUntil
+	}
+	return result
+}
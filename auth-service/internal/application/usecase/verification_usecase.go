@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/service"
+	"auth-service/pkg/utils"
+)
+
+func (uc *AuthUseCase) VerifyEmail(ctx context.Context, token string) error {
+	if token == "" {
+		return domainErr.ErrMissingToken
+	}
+
+	tokenHash := uc.tokenService.HashToken(token)
+	verification, err := uc.verificationTokenRepo.FindByTokenHash(ctx, tokenHash, entity.TokenPurposeEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	if !verification.IsValid() {
+		return domainErr.ErrInvalidToken
+	}
+
+	if err := uc.verificationTokenRepo.MarkUsed(ctx, verification.ID); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, verification.UserID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+
+	if user.IsVerified {
+		return nil
+	}
+
+	user.Verify()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return domainErr.ErrDatabase
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionAccountVerified, "", "")
+	_ = uc.auditLogRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// ResendVerification always succeeds for unknown or already verified emails
+// so the endpoint cannot be used to discover registered accounts.
+func (uc *AuthUseCase) ResendVerification(ctx context.Context, email string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		if err == domainErr.ErrUserNotFound {
+			return nil
+		}
+		return domainErr.ErrDatabase
+	}
+
+	if user.IsVerified || !user.IsActive {
+		return nil
+	}
+
+	return uc.sendVerificationEmail(ctx, user)
+}
+
+func (uc *AuthUseCase) sendVerificationEmail(ctx context.Context, user *entity.User) error {
+	if err := uc.verificationTokenRepo.InvalidateByUserID(ctx, user.ID, entity.TokenPurposeEmailVerification); err != nil {
+		return err
+	}
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposeEmailVerification, uc.config.VerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", uc.config.AppBaseURL, url.QueryEscape(plain))
+	return uc.mailSender.Send(ctx, service.MailMessage{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Welcome!\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			link, uc.config.VerificationTokenTTL,
+		),
+	})
+}
+
+// issueVerificationToken stores the hash of a fresh single-use token and
+// returns the plain value, which is only ever sent to the user.
+func (uc *AuthUseCase) issueVerificationToken(ctx context.Context, user *entity.User, purpose entity.TokenPurpose, ttl time.Duration) (string, error) {
+	plain, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", domainErr.ErrInternalServer
+	}
+
+	token := entity.NewVerificationToken(user.ID, uc.tokenService.HashToken(plain), purpose, time.Now().Add(ttl))
+	if err := uc.verificationTokenRepo.Create(ctx, token); err != nil {
+		return "", domainErr.ErrDatabase
+	}
+
+	return plain, nil
+}
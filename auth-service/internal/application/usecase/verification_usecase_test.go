@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newVerificationUseCase(user *entity.User) (*AuthUseCase, *memoryUsers, *memoryVerificationTokens) {
+	users := newMemoryUsers(user)
+	tokens := newMemoryVerificationTokens()
+	return &AuthUseCase{
+		userRepo:              users,
+		verificationTokenRepo: tokens,
+		auditLogRepo:          discardAuditLogs{},
+		tokenService:          hashingTokens{},
+	}, users, tokens
+}
+
+func TestVerifyEmailTokenWorksOnce(t *testing.T) {
+	ctx := context.Background()
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	uc, users, _ := newVerificationUseCase(user)
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposeEmailVerification, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, uc.VerifyEmail(ctx, plain))
+	stored, err := users.FindByID(ctx, user.ID)
+	require.NoError(t, err)
+	require.True(t, stored.IsVerified)
+
+	require.ErrorIs(t, uc.VerifyEmail(ctx, plain), domainErr.ErrInvalidToken)
+}
+
+func TestVerifyEmailRejectsExpiredToken(t *testing.T) {
+	ctx := context.Background()
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	uc, users, tokens := newVerificationUseCase(user)
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposeEmailVerification, time.Hour)
+	require.NoError(t, err)
+	tokens.expire()
+
+	require.ErrorIs(t, uc.VerifyEmail(ctx, plain), domainErr.ErrInvalidToken)
+	stored, err := users.FindByID(ctx, user.ID)
+	require.NoError(t, err)
+	require.False(t, stored.IsVerified)
+}
+
+func TestVerifyEmailRejectsTokenOfOtherPurpose(t *testing.T) {
+	ctx := context.Background()
+	user := entity.NewUser("someone@example.com", "hashed:Tangerine-Orbit-42")
+	uc, _, _ := newVerificationUseCase(user)
+
+	plain, err := uc.issueVerificationToken(ctx, user, entity.TokenPurposePasswordReset, time.Hour)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, uc.VerifyEmail(ctx, plain), domainErr.ErrInvalidToken)
+}
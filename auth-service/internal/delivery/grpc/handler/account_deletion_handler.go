@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) DeleteAccount(ctx context.Context, req *proto.DeleteAccountRequest) (*proto.DeleteAccountResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteDTO := dto.DeleteAccountRequest{
+		Password: req.GetPassword(),
+		Code:     req.GetCode(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+	accessToken := interceptor.GetAccessTokenFromContext(ctx)
+
+	if err := h.authUsecase.DeleteAccount(ctx, userID, deleteDTO, accessToken, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.DeleteAccountResponse{Message: "account deleted successfully"}, nil
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) CreateAPIKey(ctx context.Context, req *proto.CreateAPIKeyRequest) (*proto.CreateAPIKeyResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.CreateAPIKey(ctx, userID, dto.CreateAPIKeyRequest{
+		Name:          req.GetName(),
+		Scopes:        req.GetScopes(),
+		ExpiresInDays: req.GetExpiresInDays(),
+	}, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.CreateAPIKeyResponse{
+		ApiKey: toAPIKeyProto(result.APIKey),
+		Key:    result.Key,
+	}, nil
+}
+
+func (h *GRPCHandler) ListAPIKeys(ctx context.Context, req *proto.ListAPIKeysRequest) (*proto.ListAPIKeysResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := h.authUsecase.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListAPIKeysResponse{
+		ApiKeys: make([]*proto.APIKey, len(keys)),
+	}
+	for i, key := range keys {
+		resp.ApiKeys[i] = toAPIKeyProto(key)
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) RevokeAPIKey(ctx context.Context, req *proto.RevokeAPIKeyRequest) (*proto.RevokeAPIKeyResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.RevokeAPIKey(ctx, userID, req.GetKeyId(), ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RevokeAPIKeyResponse{Message: "api key revoked successfully"}, nil
+}
+
+func (h *GRPCHandler) IntrospectAPIKey(ctx context.Context, req *proto.IntrospectAPIKeyRequest) (*proto.IntrospectAPIKeyResponse, error) {
+	result, err := h.authUsecase.IntrospectAPIKey(ctx, req.GetKey())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.IntrospectAPIKeyResponse{
+		Active: result.Active,
+		KeyId:  result.KeyID,
+		Sub:    result.Subject,
+		Email:  result.Email,
+		Role:   result.Role,
+		Scopes: result.Scopes,
+	}, nil
+}
+
+func toAPIKeyProto(key *dto.APIKeyDTO) *proto.APIKey {
+	apiKey := &proto.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		ExpiresAt: key.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt: key.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if key.LastUsedAt != nil {
+		apiKey.LastUsedAt = key.LastUsedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return apiKey
+}
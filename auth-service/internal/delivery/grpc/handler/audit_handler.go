@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (h *GRPCHandler) GetMyActivity(ctx context.Context, req *proto.GetMyActivityRequest) (*proto.GetMyActivityResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := h.authUsecase.GetMyActivity(ctx, userID, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.GetMyActivityResponse{Events: toAuditLogEntries(events)}, nil
+}
+
+func (h *GRPCHandler) SearchAuditLogs(ctx context.Context, req *proto.SearchAuditLogsRequest) (*proto.SearchAuditLogsResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.authUsecase.SearchAuditLogs(ctx, userID, toSearchAuditLogsDTO(req))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.SearchAuditLogsResponse{
+		Logs:          toAuditLogEntries(page.Logs),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// StreamAuditLogs sends every matching entry as one JSON Lines record per
+// message, so the concatenated message bodies form a valid .jsonl file.
+func (h *GRPCHandler) StreamAuditLogs(req *proto.SearchAuditLogsRequest, stream proto.AuthService_StreamAuditLogsServer) error {
+	ctx := stream.Context()
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = h.authUsecase.StreamAuditLogs(ctx, userID, toSearchAuditLogsDTO(req), func(log *dto.AuditLogDTO) error {
+		line, err := json.Marshal(log)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&httpbody.HttpBody{
+			ContentType: "application/x-ndjson",
+			Data:        append(line, '\n'),
+		})
+	})
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return nil
+}
+
+func toSearchAuditLogsDTO(req *proto.SearchAuditLogsRequest) dto.SearchAuditLogsRequest {
+	return dto.SearchAuditLogsRequest{
+		UserID:    req.GetUserId(),
+		Actions:   req.GetActions(),
+		IPAddress: req.GetIpAddress(),
+		From:      req.GetFrom(),
+		To:        req.GetTo(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+func toAuditLogEntries(logs []*dto.AuditLogDTO) []*proto.AuditLogEntry {
+	entries := make([]*proto.AuditLogEntry, len(logs))
+	for i, log := range logs {
+		// Metadata only ever holds JSON-compatible values; drop it rather
+		// than fail the whole page if that changes.
+		metadata, _ := structpb.NewStruct(log.Metadata)
+		entries[i] = &proto.AuditLogEntry{
+			Id:        log.ID,
+			UserId:    log.UserID,
+			Action:    log.Action,
+			IpAddress: log.IPAddress,
+			UserAgent: log.UserAgent,
+			Metadata:  metadata,
+			CreatedAt: log.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return entries
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) ExportMyData(ctx context.Context, req *proto.ExportMyDataRequest) (*proto.ExportMyDataResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	export, err := h.authUsecase.RequestDataExport(ctx, userID, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ExportMyDataResponse{Export: toDataExportProto(export)}, nil
+}
+
+func (h *GRPCHandler) GetDataExport(ctx context.Context, req *proto.GetDataExportRequest) (*proto.GetDataExportResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := h.authUsecase.GetDataExport(ctx, userID, req.GetExportId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.GetDataExportResponse{Export: toDataExportProto(export)}, nil
+}
+
+func (h *GRPCHandler) DownloadDataExport(ctx context.Context, req *proto.DownloadDataExportRequest) (*proto.DownloadDataExportResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	archive, err := h.authUsecase.DownloadDataExport(ctx, userID, req.GetExportId(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.DownloadDataExportResponse{
+		FileName:    archive.FileName,
+		ContentType: "application/zip",
+		Content:     archive.Content,
+	}, nil
+}
+
+func toDataExportProto(export *dto.DataExportDTO) *proto.DataExport {
+	result := &proto.DataExport{
+		Id:        export.ID,
+		Status:    export.Status,
+		SizeBytes: export.SizeBytes,
+		CreatedAt: export.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if export.CompletedAt != nil {
+		result.CompletedAt = export.CompletedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if export.ExpiresAt != nil {
+		result.ExpiresAt = export.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) RequestEmailChange(ctx context.Context, req *proto.RequestEmailChangeRequest) (*proto.RequestEmailChangeResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changeDTO := dto.RequestEmailChangeRequest{
+		NewEmail: req.GetNewEmail(),
+		Password: req.GetPassword(),
+		Code:     req.GetCode(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.RequestEmailChange(ctx, userID, changeDTO, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RequestEmailChangeResponse{
+		Message: "a confirmation link has been sent to the new email address",
+	}, nil
+}
+
+func (h *GRPCHandler) ConfirmEmailChange(ctx context.Context, req *proto.ConfirmEmailChangeRequest) (*proto.ConfirmEmailChangeResponse, error) {
+	confirmDTO := dto.ConfirmEmailChangeRequest{
+		Token: req.GetToken(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.ConfirmEmailChange(ctx, confirmDTO.Token, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ConfirmEmailChangeResponse{Message: "email changed successfully"}, nil
+}
@@ -1,13 +1,21 @@
 package handler
 
 import (
+	"errors"
+
 	domainErr "auth-service/internal/domain/errors"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func toGRPCError(err error) error {
+	var policyErr *domainErr.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return passwordPolicyStatus(policyErr)
+	}
+
 	switch err {
 	case domainErr.ErrUserNotFound:
 		return status.Error(codes.NotFound, err.Error())
@@ -15,13 +23,56 @@ func toGRPCError(err error) error {
 		return status.Error(codes.AlreadyExists, err.Error())
 	case domainErr.ErrInvalidCredentials:
 		return status.Error(codes.Unauthenticated, err.Error())
-	case domainErr.ErrAccountLocked, domainErr.ErrAccountInactive:
+	case domainErr.ErrAccountLocked, domainErr.ErrAccountInactive, domainErr.ErrEmailNotVerified, domainErr.ErrPasswordResetRequired:
 		return status.Error(codes.PermissionDenied, err.Error())
-	case domainErr.ErrInvalidToken, domainErr.ErrTokenExpired, domainErr.ErrTokenRevoked, domainErr.ErrMissingToken:
+	case domainErr.ErrInvalidToken, domainErr.ErrTokenExpired, domainErr.ErrTokenRevoked, domainErr.ErrMissingToken, domainErr.ErrTokenReuseDetected:
 		return status.Error(codes.Unauthenticated, err.Error())
 	case domainErr.ErrWeakPassword, domainErr.ErrInvalidPassword:
 		return status.Error(codes.InvalidArgument, err.Error())
+	case domainErr.ErrInvalidMFACode, domainErr.ErrInvalidStepUpCode:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case domainErr.ErrMFAAlreadyEnabled, domainErr.ErrMFANotEnabled, domainErr.ErrMFANotEnrolled:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case domainErr.ErrInvalidInput, domainErr.ErrValidationError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case domainErr.ErrSessionNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domainErr.ErrForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domainErr.ErrCannotModifySelf:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case domainErr.ErrKeyRotationConflict:
+		return status.Error(codes.Aborted, err.Error())
+	case domainErr.ErrOAuthClientNotFound, domainErr.ErrOAuthConsentNotFound, domainErr.ErrAPIKeyNotFound, domainErr.ErrDataExportNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case domainErr.ErrDataExportNotReady, domainErr.ErrDataExportExpired:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case domainErr.ErrInvalidClient:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case domainErr.ErrUnauthorizedClient:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case domainErr.ErrInvalidGrant, domainErr.ErrInvalidRedirectURI, domainErr.ErrInvalidScope, domainErr.ErrUnsupportedGrantType:
+		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, "an internal error occurred")
 	}
 }
+
+// passwordPolicyStatus returns InvalidArgument with a BadRequest detail that
+// has one field violation per broken rule, its reason being the rule name.
+func passwordPolicyStatus(err *domainErr.PasswordPolicyError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "password",
+			Description: v.Message,
+			Reason:      v.Rule,
+		})
+	}
+
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
@@ -6,6 +6,9 @@ import (
 	"auth-service/internal/application/usecase"
 	"auth-service/internal/delivery/grpc/interceptor"
 	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCHandler struct {
@@ -51,10 +54,20 @@ func (h *GRPCHandler) Login(ctx context.Context, req *proto.LoginRequest) (*prot
 		return nil, toGRPCError(err)
 	}
 
+	return toLoginResponse(result), nil
+}
+
+func toLoginResponse(result *dto.AuthResponse) *proto.LoginResponse {
 	return &proto.LoginResponse{
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-	}, nil
+		AccessToken:         result.AccessToken,
+		RefreshToken:        result.RefreshToken,
+		MfaRequired:         result.MFARequired,
+		MfaToken:            result.MFAToken,
+		PasswordExpired:     result.PasswordExpired,
+		PasswordChangeToken: result.PasswordChangeToken,
+		StepUpRequired:      result.StepUpRequired,
+		StepUpToken:         result.StepUpToken,
+	}
 }
 
 func (h *GRPCHandler) RefreshToken(ctx context.Context, req *proto.RefreshTokenRequest) (*proto.RefreshTokenResponse, error) {
@@ -100,7 +113,15 @@ func (h *GRPCHandler) LogoutAll(ctx context.Context, req *proto.LogoutAllRequest
 	userAgent := interceptor.GetUserAgentFromContext(ctx)
 	accessToken := interceptor.GetAccessTokenFromContext(ctx)
 
-	if err := h.authUsecase.LogoutAll(ctx, userID, accessToken, ipAddress, userAgent); err != nil {
+	var keepSessionID string
+	if req.GetKeepCurrentSession() {
+		keepSessionID = interceptor.GetSessionIDFromContext(ctx)
+		if keepSessionID == "" {
+			return nil, status.Error(codes.FailedPrecondition, "current session is unknown, log in again")
+		}
+	}
+
+	if err := h.authUsecase.LogoutAll(ctx, userID, accessToken, keepSessionID, ipAddress, userAgent); err != nil {
 		return nil, toGRPCError(err)
 	}
 
@@ -124,6 +145,7 @@ func (h *GRPCHandler) GetMe(ctx context.Context, req *proto.GetMeRequest) (*prot
 		Role:       user.Role,
 		IsVerified: user.IsVerified,
 		IsActive:   user.IsActive,
+		MfaEnabled: user.MFAEnabled,
 		CreatedAt:  user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
@@ -146,9 +168,86 @@ func (h *GRPCHandler) ChangePassword(ctx context.Context, req *proto.ChangePassw
 	return &proto.ChangePasswordResponse{Message: "password changed successfully"}, nil
 }
 
+func (h *GRPCHandler) ChangeExpiredPassword(ctx context.Context, req *proto.ChangeExpiredPasswordRequest) (*proto.LoginResponse, error) {
+	changeDTO := dto.ChangeExpiredPasswordRequest{
+		PasswordChangeToken: req.GetPasswordChangeToken(),
+		NewPassword:         req.GetNewPassword(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.ChangeExpiredPassword(ctx, changeDTO, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toLoginResponse(result), nil
+}
+
 func (h *GRPCHandler) GetPublicKey(ctx context.Context, req *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error) {
 	return &proto.GetPublicKeyResponse{
 		PublicKey: h.authUsecase.GetPublicKey(ctx),
 		Algorithm: h.authUsecase.GetAlgorithm(),
+		KeyId:     h.authUsecase.GetKeyID(),
 	}, nil
 }
+
+func (h *GRPCHandler) VerifyEmail(ctx context.Context, req *proto.VerifyEmailRequest) (*proto.VerifyEmailResponse, error) {
+	verifyDTO := dto.VerifyEmailRequest{
+		Token: req.GetToken(),
+	}
+
+	if err := h.authUsecase.VerifyEmail(ctx, verifyDTO.Token); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.VerifyEmailResponse{Message: "email verified successfully"}, nil
+}
+
+func (h *GRPCHandler) ResendVerification(ctx context.Context, req *proto.ResendVerificationRequest) (*proto.ResendVerificationResponse, error) {
+	resendDTO := dto.ResendVerificationRequest{
+		Email: req.GetEmail(),
+	}
+
+	if err := h.authUsecase.ResendVerification(ctx, resendDTO.Email); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ResendVerificationResponse{
+		Message: "if the account exists and is not yet verified, a verification email has been sent",
+	}, nil
+}
+
+func (h *GRPCHandler) RequestPasswordReset(ctx context.Context, req *proto.RequestPasswordResetRequest) (*proto.RequestPasswordResetResponse, error) {
+	resetDTO := dto.RequestPasswordResetRequest{
+		Email: req.GetEmail(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.RequestPasswordReset(ctx, resetDTO.Email, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RequestPasswordResetResponse{
+		Message: "if the account exists, a password reset email has been sent",
+	}, nil
+}
+
+func (h *GRPCHandler) ResetPassword(ctx context.Context, req *proto.ResetPasswordRequest) (*proto.ResetPasswordResponse, error) {
+	resetDTO := dto.ResetPasswordRequest{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.ResetPassword(ctx, resetDTO, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ResetPasswordResponse{Message: "password reset successfully"}, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+)
+
+func (h *GRPCHandler) IntrospectToken(ctx context.Context, req *proto.IntrospectTokenRequest) (*proto.IntrospectTokenResponse, error) {
+	result, err := h.authUsecase.IntrospectToken(ctx, req.GetToken())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.IntrospectTokenResponse{
+		Active: result.Active,
+		Sub:    result.Subject,
+		Email:  result.Email,
+		Role:   result.Role,
+		Sid:    result.SessionID,
+		Iss:    result.Issuer,
+		Iat:    result.IssuedAt,
+		Exp:    result.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) VerifyLoginStepUp(ctx context.Context, req *proto.VerifyLoginStepUpRequest) (*proto.LoginResponse, error) {
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.VerifyLoginStepUp(ctx, dto.VerifyLoginStepUpRequest{
+		StepUpToken: req.GetStepUpToken(),
+		Code:        req.GetCode(),
+	}, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toLoginResponse(result), nil
+}
+
+func (h *GRPCHandler) ReportUnrecognizedLogin(ctx context.Context, req *proto.ReportUnrecognizedLoginRequest) (*proto.ReportUnrecognizedLoginResponse, error) {
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.ReportUnrecognizedLogin(ctx, dto.ReportUnrecognizedLoginRequest{
+		Token:     req.GetToken(),
+		SessionID: req.GetSessionId(),
+	}, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ReportUnrecognizedLoginResponse{Message: "session signed out"}, nil
+}
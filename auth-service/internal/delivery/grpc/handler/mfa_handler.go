@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) VerifyMFA(ctx context.Context, req *proto.VerifyMFARequest) (*proto.LoginResponse, error) {
+	verifyDTO := dto.VerifyMFARequest{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.VerifyMFA(ctx, verifyDTO, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toLoginResponse(result), nil
+}
+
+func (h *GRPCHandler) EnrollMFA(ctx context.Context, req *proto.EnrollMFARequest) (*proto.EnrollMFAResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.authUsecase.EnrollMFA(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.EnrollMFAResponse{
+		Secret:     result.Secret,
+		OtpauthUri: result.OTPAuthURI,
+	}, nil
+}
+
+func (h *GRPCHandler) ConfirmMFA(ctx context.Context, req *proto.ConfirmMFARequest) (*proto.ConfirmMFAResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	recoveryCodes, err := h.authUsecase.ConfirmMFA(ctx, userID, req.GetCode(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.ConfirmMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *GRPCHandler) DisableMFA(ctx context.Context, req *proto.DisableMFARequest) (*proto.DisableMFAResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	disableDTO := dto.DisableMFARequest{
+		Password: req.GetPassword(),
+		Code:     req.GetCode(),
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.DisableMFA(ctx, userID, disableDTO, ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.DisableMFAResponse{Message: "mfa disabled successfully"}, nil
+}
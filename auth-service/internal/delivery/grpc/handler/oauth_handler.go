@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) Authorize(ctx context.Context, req *proto.AuthorizeRequest) (*proto.AuthorizeResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.authUsecase.Authorize(ctx, userID, dto.AuthorizeRequest{
+		ResponseType:        req.GetResponseType(),
+		ClientID:            req.GetClientId(),
+		RedirectURI:         req.GetRedirectUri(),
+		Scope:               req.GetScope(),
+		State:               req.GetState(),
+		CodeChallenge:       req.GetCodeChallenge(),
+		CodeChallengeMethod: req.GetCodeChallengeMethod(),
+		Nonce:               req.GetNonce(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toAuthorizeResponseProto(result), nil
+}
+
+func (h *GRPCHandler) GrantConsent(ctx context.Context, req *proto.GrantConsentRequest) (*proto.AuthorizeResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.GrantConsent(ctx, userID, dto.AuthorizeRequest{
+		ResponseType:        req.GetResponseType(),
+		ClientID:            req.GetClientId(),
+		RedirectURI:         req.GetRedirectUri(),
+		Scope:               req.GetScope(),
+		State:               req.GetState(),
+		CodeChallenge:       req.GetCodeChallenge(),
+		CodeChallengeMethod: req.GetCodeChallengeMethod(),
+		Nonce:               req.GetNonce(),
+	}, req.GetApproved(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toAuthorizeResponseProto(result), nil
+}
+
+func (h *GRPCHandler) OAuthToken(ctx context.Context, req *proto.OAuthTokenRequest) (*proto.OAuthTokenResponse, error) {
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.OAuthToken(ctx, dto.OAuthTokenRequest{
+		GrantType:    req.GetGrantType(),
+		Code:         req.GetCode(),
+		RedirectURI:  req.GetRedirectUri(),
+		CodeVerifier: req.GetCodeVerifier(),
+		RefreshToken: req.GetRefreshToken(),
+		ClientID:     req.GetClientId(),
+		ClientSecret: req.GetClientSecret(),
+		Scope:        req.GetScope(),
+	}, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.OAuthTokenResponse{
+		AccessToken:  result.AccessToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    result.ExpiresIn,
+		RefreshToken: result.RefreshToken,
+		IdToken:      result.IDToken,
+		Scope:        result.Scope,
+	}, nil
+}
+
+func (h *GRPCHandler) ListOAuthConsents(ctx context.Context, req *proto.ListOAuthConsentsRequest) (*proto.ListOAuthConsentsResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consents, err := h.authUsecase.ListOAuthConsents(ctx, userID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListOAuthConsentsResponse{
+		Consents: make([]*proto.OAuthConsent, len(consents)),
+	}
+	for i, consent := range consents {
+		resp.Consents[i] = &proto.OAuthConsent{
+			ClientId:   consent.ClientID,
+			ClientName: consent.ClientName,
+			Scopes:     consent.Scopes,
+			GrantedAt:  consent.GrantedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) RevokeOAuthConsent(ctx context.Context, req *proto.RevokeOAuthConsentRequest) (*proto.RevokeOAuthConsentResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.RevokeOAuthConsent(ctx, userID, req.GetClientId(), ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RevokeOAuthConsentResponse{Message: "consent revoked successfully"}, nil
+}
+
+func (h *GRPCHandler) CreateOAuthClient(ctx context.Context, req *proto.CreateOAuthClientRequest) (*proto.CreateOAuthClientResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	result, err := h.authUsecase.CreateOAuthClient(ctx, actorID, dto.CreateOAuthClientRequest{
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+		Scopes:       req.GetScopes(),
+		Confidential: req.GetConfidential(),
+		FirstParty:   req.GetFirstParty(),
+		Machine:      req.GetMachine(),
+	}, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.CreateOAuthClientResponse{
+		Client:       toOAuthClientProto(result.Client),
+		ClientSecret: result.ClientSecret,
+	}, nil
+}
+
+func (h *GRPCHandler) ListOAuthClients(ctx context.Context, req *proto.ListOAuthClientsRequest) (*proto.ListOAuthClientsResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := h.authUsecase.ListOAuthClients(ctx, actorID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListOAuthClientsResponse{
+		Clients: make([]*proto.OAuthClient, len(clients)),
+	}
+	for i, client := range clients {
+		resp.Clients[i] = toOAuthClientProto(client)
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) DeleteOAuthClient(ctx context.Context, req *proto.DeleteOAuthClientRequest) (*proto.DeleteOAuthClientResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.DeleteOAuthClient(ctx, actorID, req.GetClientId(), ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.DeleteOAuthClientResponse{Message: "oauth client deleted successfully"}, nil
+}
+
+func (h *GRPCHandler) GetOpenIDConfiguration(ctx context.Context, req *proto.GetOpenIDConfigurationRequest) (*proto.GetOpenIDConfigurationResponse, error) {
+	config := h.authUsecase.GetOpenIDConfiguration()
+
+	return &proto.GetOpenIDConfigurationResponse{
+		Issuer:                            config.Issuer,
+		AuthorizationEndpoint:             config.AuthorizationEndpoint,
+		TokenEndpoint:                     config.TokenEndpoint,
+		JwksUri:                           config.JWKSURI,
+		ScopesSupported:                   config.ScopesSupported,
+		ResponseTypesSupported:            config.ResponseTypesSupported,
+		GrantTypesSupported:               config.GrantTypesSupported,
+		SubjectTypesSupported:             config.SubjectTypesSupported,
+		IdTokenSigningAlgValuesSupported:  config.IDTokenSigningAlgValuesSupported,
+		TokenEndpointAuthMethodsSupported: config.TokenEndpointAuthMethodsSupported,
+		CodeChallengeMethodsSupported:     config.CodeChallengeMethodsSupported,
+		ClaimsSupported:                   config.ClaimsSupported,
+	}, nil
+}
+
+func toAuthorizeResponseProto(result *dto.AuthorizeResponse) *proto.AuthorizeResponse {
+	return &proto.AuthorizeResponse{
+		RedirectTo:      result.RedirectTo,
+		ConsentRequired: result.ConsentRequired,
+		ClientName:      result.ClientName,
+		Scopes:          result.Scopes,
+	}
+}
+
+func toOAuthClientProto(client *dto.OAuthClientDTO) *proto.OAuthClient {
+	return &proto.OAuthClient{
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Confidential: client.Confidential,
+		FirstParty:   client.FirstParty,
+		CreatedAt:    client.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Machine:      client.Machine,
+	}
+}
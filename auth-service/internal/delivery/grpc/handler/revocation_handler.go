@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	proto "auth-service/gen/go"
+)
+
+func (h *GRPCHandler) ListRevokedTokens(ctx context.Context, req *proto.ListRevokedTokensRequest) (*proto.ListRevokedTokensResponse, error) {
+	var since time.Time
+	if req.GetSinceUnixMs() > 0 {
+		since = time.UnixMilli(req.GetSinceUnixMs())
+	}
+
+	page, err := h.authUsecase.ListRevokedTokens(ctx, since)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListRevokedTokensResponse{
+		Tokens:          make([]*proto.RevokedToken, len(page.Tokens)),
+		NextSinceUnixMs: page.NextSince.UnixMilli(),
+		HasMore:         page.HasMore,
+	}
+	for i, token := range page.Tokens {
+		resp.Tokens[i] = &proto.RevokedToken{
+			TokenHash:     token.TokenHash,
+			ExpiresAtUnix: token.ExpiresAt.Unix(),
+		}
+	}
+
+	return resp, nil
+}
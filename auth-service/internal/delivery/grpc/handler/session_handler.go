@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) ListSessions(ctx context.Context, req *proto.ListSessionsRequest) (*proto.ListSessionsResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.authUsecase.ListSessions(ctx, userID, interceptor.GetSessionIDFromContext(ctx))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListSessionsResponse{
+		Sessions: make([]*proto.Session, len(sessions)),
+	}
+	for i, session := range sessions {
+		resp.Sessions[i] = &proto.Session{
+			Id:         session.ID,
+			IpAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			LastUsedAt: session.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"),
+			Current:    session.Current,
+		}
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) RevokeSession(ctx context.Context, req *proto.RevokeSessionRequest) (*proto.RevokeSessionResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	if err := h.authUsecase.RevokeSession(ctx, userID, req.GetSessionId(), ipAddress, userAgent); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RevokeSessionResponse{Message: "session revoked successfully"}, nil
+}
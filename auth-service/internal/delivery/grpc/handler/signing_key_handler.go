@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) GetJWKS(ctx context.Context, req *proto.GetJWKSRequest) (*proto.GetJWKSResponse, error) {
+	keys := h.authUsecase.GetJWKS(ctx)
+
+	resp := &proto.GetJWKSResponse{
+		Keys: make([]*proto.JSONWebKey, len(keys)),
+	}
+	for i, key := range keys {
+		resp.Keys[i] = &proto.JSONWebKey{
+			Kid: key.KeyID,
+			Kty: key.KeyType,
+			Alg: key.Algorithm,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Curve,
+			X:   key.X,
+			Y:   key.Y,
+		}
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) RotateSigningKeys(ctx context.Context, req *proto.RotateSigningKeysRequest) (*proto.RotateSigningKeysResponse, error) {
+	userID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	keyID, err := h.authUsecase.RotateSigningKeys(ctx, userID, ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.RotateSigningKeysResponse{
+		KeyId:   keyID,
+		Message: "signing key rotated successfully",
+	}, nil
+}
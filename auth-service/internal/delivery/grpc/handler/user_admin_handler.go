@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/application/dto"
+	"auth-service/internal/delivery/grpc/interceptor"
+)
+
+func (h *GRPCHandler) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.authUsecase.ListUsers(ctx, actorID, dto.ListUsersRequest{
+		Query:    req.GetQuery(),
+		Role:     req.GetRole(),
+		IsActive: req.IsActive,
+		Locked:   req.Locked,
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ListUsersResponse{
+		Users:    make([]*proto.AdminUser, len(page.Users)),
+		Total:    page.Total,
+		Page:     int32(page.Page),
+		PageSize: int32(page.PageSize),
+	}
+	for i, user := range page.Users {
+		resp.Users[i] = toAdminUserProto(user)
+	}
+
+	return resp, nil
+}
+
+func (h *GRPCHandler) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.GetUserResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.authUsecase.GetUser(ctx, actorID, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.GetUserResponse{User: toAdminUserProto(user)}, nil
+}
+
+func (h *GRPCHandler) LockUser(ctx context.Context, req *proto.LockUserRequest) (*proto.AdminUserActionResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	user, err := h.authUsecase.LockUser(ctx, actorID, req.GetUserId(), req.GetDuration(), req.GetReason(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.AdminUserActionResponse{
+		User:    toAdminUserProto(user),
+		Message: "user locked successfully",
+	}, nil
+}
+
+func (h *GRPCHandler) UnlockUser(ctx context.Context, req *proto.AdminUserActionRequest) (*proto.AdminUserActionResponse, error) {
+	return h.adminUserAction(ctx, req, h.authUsecase.UnlockUser, "user unlocked successfully")
+}
+
+func (h *GRPCHandler) ActivateUser(ctx context.Context, req *proto.AdminUserActionRequest) (*proto.AdminUserActionResponse, error) {
+	return h.adminUserAction(ctx, req, h.authUsecase.ActivateUser, "user activated successfully")
+}
+
+func (h *GRPCHandler) DeactivateUser(ctx context.Context, req *proto.AdminUserActionRequest) (*proto.AdminUserActionResponse, error) {
+	return h.adminUserAction(ctx, req, h.authUsecase.DeactivateUser, "user deactivated successfully")
+}
+
+func (h *GRPCHandler) ForcePasswordReset(ctx context.Context, req *proto.AdminUserActionRequest) (*proto.AdminUserActionResponse, error) {
+	return h.adminUserAction(ctx, req, h.authUsecase.ForcePasswordReset, "password reset required; reset email sent")
+}
+
+func (h *GRPCHandler) ChangeUserRole(ctx context.Context, req *proto.ChangeUserRoleRequest) (*proto.AdminUserActionResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	user, err := h.authUsecase.ChangeUserRole(ctx, actorID, req.GetUserId(), req.GetRole(), req.GetReason(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.AdminUserActionResponse{
+		User:    toAdminUserProto(user),
+		Message: "user role changed successfully",
+	}, nil
+}
+
+type adminUserActionFunc func(ctx context.Context, actorID, userID, reason, ipAddress, userAgent string) (*dto.AdminUserDTO, error)
+
+func (h *GRPCHandler) adminUserAction(ctx context.Context, req *proto.AdminUserActionRequest, action adminUserActionFunc, message string) (*proto.AdminUserActionResponse, error) {
+	actorID, err := interceptor.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ipAddress := interceptor.GetClientIPFromContext(ctx)
+	userAgent := interceptor.GetUserAgentFromContext(ctx)
+
+	user, err := action(ctx, actorID, req.GetUserId(), req.GetReason(), ipAddress, userAgent)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.AdminUserActionResponse{
+		User:    toAdminUserProto(user),
+		Message: message,
+	}, nil
+}
+
+func toAdminUserProto(user *dto.AdminUserDTO) *proto.AdminUser {
+	result := &proto.AdminUser{
+		Id:                    user.ID,
+		Email:                 user.Email,
+		Role:                  user.Role,
+		IsVerified:            user.IsVerified,
+		IsActive:              user.IsActive,
+		MfaEnabled:            user.MFAEnabled,
+		IsLocked:              user.IsLocked,
+		FailedLoginAttempts:   int32(user.FailedLoginAttempts),
+		PasswordResetRequired: user.PasswordResetRequired,
+		LastLoginIp:           user.LastLoginIP,
+		CreatedAt:             user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:             user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if user.LockedUntil != nil {
+		result.LockedUntil = user.LockedUntil.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if user.LastLoginAt != nil {
+		result.LastLoginAt = user.LastLoginAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return result
+}
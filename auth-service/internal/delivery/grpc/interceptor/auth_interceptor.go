@@ -17,80 +17,207 @@ const (
 	UserIDKey      contextKey = "user_id"
 	UserEmailKey   contextKey = "user_email"
 	UserRoleKey    contextKey = "user_role"
+	SessionIDKey   contextKey = "session_id"
 	ClientIPKey    contextKey = "client_ip"
 	UserAgentKey   contextKey = "user_agent"
 	AccessTokenKey contextKey = "access_token"
 )
 
 var publicMethods = map[string]bool{
-	"/proto.AuthService/HealthCheck":  true,
-	"/proto.AuthService/Register":     true,
-	"/proto.AuthService/Login":        true,
-	"/proto.AuthService/GetPublicKey": true,
+	"/proto.AuthService/HealthCheck":          true,
+	"/proto.AuthService/Register":             true,
+	"/proto.AuthService/Login":                true,
+	"/proto.AuthService/GetPublicKey":         true,
+	"/proto.AuthService/VerifyEmail":          true,
+	"/proto.AuthService/ConfirmEmailChange":   true,
+	"/proto.AuthService/ResendVerification":   true,
+	"/proto.AuthService/RequestPasswordReset": true,
+	"/proto.AuthService/ResetPassword":        true,
+	"/proto.AuthService/VerifyMFA":            true,
+	"/proto.AuthService/GetJWKS":              true,
+	// Authenticated by the refresh token. The access token it replaces may
+	// be expired or already revoked, so it is only passed on, unverified, to
+	// be blacklisted; a replayed refresh token must reach reuse detection.
+	"/proto.AuthService/RefreshToken": true,
+	// Authenticated by the password_change token that Login returned.
+	"/proto.AuthService/ChangeExpiredPassword": true,
+	// Authenticated by the step-up token and code, or the token of a
+	// new-device email.
+	"/proto.AuthService/VerifyLoginStepUp":       true,
+	"/proto.AuthService/ReportUnrecognizedLogin": true,
+	// Called by OAuth clients, which authenticate with their own
+	// credentials rather than a user's access token.
+	"/proto.AuthService/OAuthToken":             true,
+	"/proto.AuthService/GetOpenIDConfiguration": true,
 }
 
-func NewAuthInterceptor(tokenService TokenValidator) grpc.UnaryServerInterceptor {
+// serviceMethods are the RPCs other services call with their own
+// client_credentials token, and the scope each one requires. They have no
+// HTTP route on the gateway, and user tokens cannot call them.
+var serviceMethods = map[string]string{
+	"/proto.AuthService/ListRevokedTokens": "tokens.introspect",
+	"/proto.AuthService/IntrospectToken":   "tokens.introspect",
+	"/proto.AuthService/IntrospectAPIKey":  "tokens.introspect",
+}
+
+// clientMethods are the RPCs an access token issued to an OAuth client may
+// call and the scope each one requires. Such a token acts for the user only
+// within the scopes they consented to, so every other method is closed to
+// it.
+var clientMethods = map[string]string{
+	"/proto.AuthService/GetMe": "email",
+}
+
+// NewAuthInterceptor verifies the caller's token and puts their identity,
+// IP address and user agent in the context. The IP address is taken from
+// X-Forwarded-For only when proxies sent it, as the known-device checks
+// trust it.
+func NewAuthInterceptor(tokenService TokenValidator, revocations RevocationChecker, proxies TrustedProxies) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
+		ctx, err := authenticate(ctx, info.FullMethod, tokenService, revocations, proxies)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Extract client info
-		if ips := md.Get("x-forwarded-for"); len(ips) > 0 {
-			ctx = context.WithValue(ctx, ClientIPKey, ips[0])
+// NewAuthStreamInterceptor applies the same checks as NewAuthInterceptor to
+// streaming RPCs.
+func NewAuthStreamInterceptor(tokenService TokenValidator, revocations RevocationChecker, proxies TrustedProxies) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod, tokenService, revocations, proxies)
+		if err != nil {
+			return err
 		}
-		if agents := md.Get("user-agent"); len(agents) > 0 {
-			ctx = context.WithValue(ctx, UserAgentKey, agents[0])
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, fullMethod string, tokenService TokenValidator, revocations RevocationChecker, proxies TrustedProxies) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+
+	// Extract client info
+	if ip := proxies.ClientIP(ctx); ip != "" {
+		ctx = context.WithValue(ctx, ClientIPKey, ip)
+	}
+	if agents := md.Get("user-agent"); len(agents) > 0 {
+		ctx = context.WithValue(ctx, UserAgentKey, agents[0])
+	}
+
+	// Public methods don't need authentication
+	if publicMethods[fullMethod] {
+		if token := bearerToken(md); token != "" {
+			ctx = context.WithValue(ctx, AccessTokenKey, token)
 		}
+		return ctx, nil
+	}
 
-		// Public methods don't need authentication
-		if publicMethods[info.FullMethod] {
-			return handler(ctx, req)
+	// The token is verified here rather than trusted because Kong forwarded
+	// it, so the service is safe to call around the gateway.
+	token := bearerToken(md)
+	if token == "" {
+		log.Println("❌ Request without bearer token")
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	if scope, ok := serviceMethods[fullMethod]; ok {
+		claims, err := tokenService.ValidateServiceToken(token)
+		if err != nil {
+			log.Println("⚠️  Rejected service token:", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid service token")
+		}
+		if !hasScope(claims.Scope, scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "token lacks the %s scope", scope)
 		}
+		return ctx, nil
+	}
 
-		// Check if request was authenticated by Kong Gateway
-		if kongConsumerID := md.Get("x-consumer-id"); len(kongConsumerID) > 0 {
-			log.Println("✅ Request authenticated by Kong Gateway, consumer:", kongConsumerID[0])
-
-			// Extract JWT token and parse claims (Kong already validated it)
-			authHeaders := md.Get("authorization")
-			if len(authHeaders) > 0 {
-				parts := strings.SplitN(authHeaders[0], " ", 2)
-				if len(parts) == 2 {
-					token := parts[1]
-					// Parse claims without validation (Kong already did it)
-					claims, err := tokenService.ExtractClaims(token)
-					if err == nil {
-						ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-						ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
-						ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
-						ctx = context.WithValue(ctx, AccessTokenKey, token)
-						log.Println("📋 Extracted user info - UserID:", claims.UserID, "Email:", claims.Email,
-							"Role:", claims.Role)
-						return handler(ctx, req)
-					}
-					log.Println("⚠️  Failed to extract claims from JWT:", err)
-				}
-			}
-
-			return nil, status.Error(codes.Internal, "failed to extract user info from JWT")
+	claims, err := tokenService.ValidateToken(token)
+	if err != nil {
+		log.Println("⚠️  Rejected access token:", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	// Kong cannot see revocations, so logged-out tokens are rejected here.
+	if revocations.IsRevoked(token, claims.UserID) {
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+	if claims.ClientID != "" {
+		scope, ok := clientMethods[fullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "method is not available to oauth clients")
+		}
+		if !hasScope(claims.Scope, scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "token lacks the %s scope", scope)
 		}
+	}
+
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
+	ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+	ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
+	ctx = context.WithValue(ctx, AccessTokenKey, token)
+	return ctx, nil
+}
+
+func bearerToken(md metadata.MD) string {
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return ""
+	}
+	parts := strings.SplitN(authHeaders[0], " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
 
-		// If no Kong consumer header, request is unauthenticated
-		log.Println("❌ Request not authenticated by Kong Gateway")
-		return nil, status.Error(codes.Unauthenticated, "unauthorized: request must go through API gateway")
+// hasScope reports whether scope is one of the space-separated scopes.
+func hasScope(scopes, scope string) bool {
+	for _, s := range strings.Fields(scopes) {
+		if s == scope {
+			return true
+		}
 	}
+	return false
 }
 
+// TokenValidator verifies an access token's signature and its exp, nbf and
+// iss claims.
 type TokenValidator interface {
-	ExtractClaims(token string) (*TokenClaims, error)
+	ValidateToken(token string) (*TokenClaims, error)
+	// ValidateServiceToken does the same for a machine client's token.
+	ValidateServiceToken(token string) (*TokenClaims, error)
+}
+
+// RevocationChecker reports whether an access token was revoked, e.g. by
+// logout, or every token of its user was, as when the account is deleted.
+// It must not hit the database; see the revocation package.
+type RevocationChecker interface {
+	IsRevoked(token, userID string) bool
 }
 
+// TokenClaims is the identity an access token carries. ClientID and Scope
+// are set only on tokens issued to OAuth clients.
 type TokenClaims struct {
-	UserID string
-	Email  string
-	Role   string
+	UserID    string
+	Email     string
+	Role      string
+	SessionID string
+	ClientID  string
+	Scope     string
 }
 
 func GetUserIDFromContext(ctx context.Context) (string, error) {
@@ -101,6 +228,14 @@ func GetUserIDFromContext(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// GetSessionIDFromContext returns the session (refresh-token family) the
+// access token was issued for. It is empty for tokens issued before sessions
+// were tracked.
+func GetSessionIDFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(SessionIDKey).(string)
+	return sessionID
+}
+
 func GetClientIPFromContext(ctx context.Context) string {
 	ip, _ := ctx.Value(ClientIPKey).(string)
 	return ip
@@ -111,6 +246,8 @@ func GetUserAgentFromContext(ctx context.Context) string {
 	return agent
 }
 
+// GetAccessTokenFromContext returns the caller's bearer token. On public
+// methods it has not been verified.
 func GetAccessTokenFromContext(ctx context.Context) string {
 	token, _ := ctx.Value(AccessTokenKey).(string)
 	return token
@@ -0,0 +1,138 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// stubValidator accepts the token "valid" with claims, as a user's or a
+// machine client's token depending on whether claims has a user ID.
+type stubValidator struct {
+	claims *TokenClaims
+}
+
+func (v stubValidator) ValidateToken(token string) (*TokenClaims, error) {
+	if token != "valid" || v.claims.UserID == "" {
+		return nil, errors.New("invalid token")
+	}
+	return v.claims, nil
+}
+
+func (v stubValidator) ValidateServiceToken(token string) (*TokenClaims, error) {
+	if token != "valid" || v.claims.UserID != "" {
+		return nil, errors.New("invalid token")
+	}
+	return v.claims, nil
+}
+
+type noRevocations struct{}
+
+func (noRevocations) IsRevoked(string, string) bool { return false }
+
+func callAs(t *testing.T, claims *TokenClaims, method string) error {
+	t.Helper()
+	intercept := NewAuthInterceptor(stubValidator{claims: claims}, noRevocations{}, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer valid"))
+	_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestOAuthClientTokenCannotCallNonGrantedMethods(t *testing.T) {
+	claims := &TokenClaims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "admin", ClientID: "client-1", Scope: "openid email"}
+
+	require.NoError(t, callAs(t, claims, "/proto.AuthService/GetMe"))
+	for _, method := range []string{
+		"/proto.AuthService/ListSessions",
+		"/proto.AuthService/CreateAPIKey",
+		"/proto.AuthService/ChangePassword",
+		"/proto.AuthService/ListUsers",
+	} {
+		err := callAs(t, claims, method)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), method)
+	}
+}
+
+func TestOAuthClientTokenNeedsScopeOfMethod(t *testing.T) {
+	claims := &TokenClaims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "user", ClientID: "client-1", Scope: "openid"}
+
+	err := callAs(t, claims, "/proto.AuthService/GetMe")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUserTokenIsNotLimitedByClientMethods(t *testing.T) {
+	claims := &TokenClaims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "user"}
+
+	require.NoError(t, callAs(t, claims, "/proto.AuthService/ListSessions"))
+}
+
+var introspectionMethods = []string{
+	"/proto.AuthService/ListRevokedTokens",
+	"/proto.AuthService/IntrospectToken",
+	"/proto.AuthService/IntrospectAPIKey",
+}
+
+func TestIntrospectionNeedsServiceToken(t *testing.T) {
+	intercept := NewAuthInterceptor(stubValidator{claims: &TokenClaims{}}, noRevocations{}, nil)
+	for _, method := range introspectionMethods {
+		_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		require.Equal(t, codes.Unauthenticated, status.Code(err), method)
+	}
+}
+
+func TestIntrospectionRejectsUserTokens(t *testing.T) {
+	claims := &TokenClaims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "admin"}
+
+	for _, method := range introspectionMethods {
+		require.Equal(t, codes.Unauthenticated, status.Code(callAs(t, claims, method)), method)
+	}
+}
+
+func TestIntrospectionNeedsScope(t *testing.T) {
+	claims := &TokenClaims{Role: "service", ClientID: "order-service", Scope: "users.read"}
+
+	for _, method := range introspectionMethods {
+		require.Equal(t, codes.PermissionDenied, status.Code(callAs(t, claims, method)), method)
+	}
+}
+
+func TestServiceTokenWithScopeCanIntrospect(t *testing.T) {
+	claims := &TokenClaims{Role: "service", ClientID: "order-service", Scope: "users.read tokens.introspect"}
+
+	for _, method := range introspectionMethods {
+		require.NoError(t, callAs(t, claims, method), method)
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(callAs(t, claims, "/proto.AuthService/GetMe")))
+}
+
+func clientIPSeenBy(t *testing.T, proxies TrustedProxies, ctx context.Context) string {
+	t.Helper()
+	intercept := NewAuthInterceptor(stubValidator{claims: &TokenClaims{}}, noRevocations{}, proxies)
+	var ip string
+	_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/proto.AuthService/Login"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		ip = GetClientIPFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	return ip
+}
+
+func TestClientIPCannotBeForgedWithForwardedFor(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"172.28.0.10"})
+	require.NoError(t, err)
+
+	// A caller that bypasses Kong cannot pose as a known device's address...
+	require.Equal(t, "203.0.113.7", clientIPSeenBy(t, proxies, callFrom("203.0.113.7", "198.51.100.1")))
+	// ...nor can one that goes through it.
+	require.Equal(t, "203.0.113.7", clientIPSeenBy(t, proxies, callFrom("172.28.0.10", "198.51.100.1, 203.0.113.7")))
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func callFrom(peerAddr string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerAddr), Port: 40000}})
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor[0]))
+	}
+	return ctx
+}
+
+func TestClientIPIgnoresForwardedForFromUntrustedPeer(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"172.28.0.10"})
+	require.NoError(t, err)
+
+	require.Equal(t, "203.0.113.7", proxies.ClientIP(callFrom("203.0.113.7", "198.51.100.1")))
+	require.Equal(t, "203.0.113.7", proxies.ClientIP(callFrom("203.0.113.7")))
+}
+
+func TestClientIPUsesLastUntrustedHop(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"172.28.0.10", "10.0.0.0/8"})
+	require.NoError(t, err)
+
+	// The client forged the first hop; Kong appended the address it saw.
+	require.Equal(t, "198.51.100.1", proxies.ClientIP(callFrom("172.28.0.10", "192.0.2.99, 198.51.100.1")))
+	// A load balancer in front of Kong is trusted as well.
+	require.Equal(t, "198.51.100.1", proxies.ClientIP(callFrom("172.28.0.10", "192.0.2.99, 198.51.100.1, 10.1.2.3")))
+	// Without the header, the proxy itself is the client.
+	require.Equal(t, "172.28.0.10", proxies.ClientIP(callFrom("172.28.0.10")))
+}
+
+func TestParseTrustedProxiesRejectsMalformedEntries(t *testing.T) {
+	for _, entry := range []string{"kong", "10.0.0.0/33", "300.1.1.1"} {
+		_, err := ParseTrustedProxies([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
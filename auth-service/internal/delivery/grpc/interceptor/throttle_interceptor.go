@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	loginMethod    = "/proto.AuthService/Login"
+	registerMethod = "/proto.AuthService/Register"
+)
+
+// Throttler decides whether a login or registration may go ahead, and how
+// long it has to wait otherwise; see the throttle package.
+type Throttler interface {
+	AttemptLogin(ctx context.Context, ip, email string) (uuid.UUID, time.Duration, error)
+	ForgetLogin(ctx context.Context, attemptID uuid.UUID) error
+	LoginSucceeded(ctx context.Context, attemptID uuid.UUID, ip, email string) error
+	AttemptRegister(ctx context.Context, ip string) (time.Duration, error)
+}
+
+type emailRequest interface {
+	GetEmail() string
+}
+
+// NewThrottleInterceptor throttles Login and Register per client IP, which is
+// taken from X-Forwarded-For only when proxies sent it. Throttled calls fail
+// with ResourceExhausted, a RetryInfo detail and a retry-after header in
+// seconds. If the throttle store fails, calls are let through: the
+// per-account lock still applies.
+func NewThrottleInterceptor(throttler Throttler, proxies TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		switch info.FullMethod {
+		case loginMethod:
+			return throttleLogin(ctx, req, throttler, proxies.ClientIP(ctx), handler)
+		case registerMethod:
+			return throttleRegister(ctx, req, throttler, proxies.ClientIP(ctx), handler)
+		default:
+			return handler(ctx, req)
+		}
+	}
+}
+
+func throttleLogin(ctx context.Context, req interface{}, throttler Throttler, ip string, handler grpc.UnaryHandler) (interface{}, error) {
+	email := ""
+	if r, ok := req.(emailRequest); ok {
+		email = r.GetEmail()
+	}
+
+	attemptID, wait, err := throttler.AttemptLogin(ctx, ip, email)
+	if err != nil {
+		log.Println("⚠️  Login throttle check failed:", err)
+		attemptID = uuid.Nil
+	} else if wait > 0 {
+		return nil, throttledError(ctx, wait)
+	}
+
+	resp, err := handler(ctx, req)
+	if attemptID == uuid.Nil {
+		return resp, err
+	}
+	// The attempt was counted as a failure up front. Only wrong credentials
+	// are failures; a locked or unverified account is not a guess.
+	switch {
+	case status.Code(err) == codes.Unauthenticated:
+	case err == nil:
+		if clearErr := throttler.LoginSucceeded(ctx, attemptID, ip, email); clearErr != nil {
+			log.Println("⚠️  Failed to clear failed logins:", clearErr)
+		}
+	default:
+		if forgetErr := throttler.ForgetLogin(ctx, attemptID); forgetErr != nil {
+			log.Println("⚠️  Failed to forget login attempt:", forgetErr)
+		}
+	}
+	return resp, err
+}
+
+func throttleRegister(ctx context.Context, req interface{}, throttler Throttler, ip string, handler grpc.UnaryHandler) (interface{}, error) {
+	wait, err := throttler.AttemptRegister(ctx, ip)
+	if err != nil {
+		log.Println("⚠️  Register throttle check failed:", err)
+	} else if wait > 0 {
+		return nil, throttledError(ctx, wait)
+	}
+	return handler(ctx, req)
+}
+
+func throttledError(ctx context.Context, wait time.Duration) error {
+	seconds := int64(math.Ceil(wait.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(seconds, 10)))
+
+	st, err := status.New(codes.ResourceExhausted, "too many attempts, try again later").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(seconds) * time.Second)})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "too many attempts, try again later")
+	}
+	return st.Err()
+}
@@ -12,15 +12,31 @@ func NewTokenServiceAdapter(tokenService service.TokenService) *TokenServiceAdap
 	}
 }
 
-func (a *TokenServiceAdapter) ExtractClaims(token string) (*TokenClaims, error) {
-	claims, err := a.tokenService.ExtractClaimsWithoutValidation(token)
+func (a *TokenServiceAdapter) ValidateToken(token string) (*TokenClaims, error) {
+	claims, err := a.tokenService.ValidateAccessToken(token)
 	if err != nil {
 		return nil, err
 	}
 
 	return &TokenClaims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-		Role:   claims.Role,
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scope:     claims.Scope,
+	}, nil
+}
+
+func (a *TokenServiceAdapter) ValidateServiceToken(token string) (*TokenClaims, error) {
+	claims, err := a.tokenService.ValidateServiceToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenClaims{
+		Role:     claims.Role,
+		ClientID: claims.ClientID,
+		Scope:    claims.Scope,
 	}, nil
 }
@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountDeletion tracks the erasure of a deleted account's data held by the
+// other services. The auth-service's own data is erased when the account is
+// deleted; the user-service profile and the personal data on orders are
+// erased by a background job, which calls each service until it confirms and
+// backs off between failed attempts.
+type AccountDeletion struct {
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	ProfileDeletedAt   *time.Time
+	OrdersAnonymizedAt *time.Time
+	Attempts           int
+	LastError          string
+	NextAttemptAt      time.Time
+	CompletedAt        *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func NewAccountDeletion(userID uuid.UUID) *AccountDeletion {
+	now := time.Now()
+	return &AccountDeletion{
+		ID:            uuid.New(),
+		UserID:        userID,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (d *AccountDeletion) IsCompleted() bool {
+	return d.CompletedAt != nil
+}
+
+func (d *AccountDeletion) MarkProfileDeleted() {
+	now := time.Now()
+	d.ProfileDeletedAt = &now
+	d.UpdatedAt = now
+	d.completeIfDone()
+}
+
+func (d *AccountDeletion) MarkOrdersAnonymized() {
+	now := time.Now()
+	d.OrdersAnonymizedAt = &now
+	d.UpdatedAt = now
+	d.completeIfDone()
+}
+
+// Fail records a failed attempt and schedules the next one, baseDelay after
+// the first failure and doubling up to maxDelay.
+func (d *AccountDeletion) Fail(reason string, baseDelay, maxDelay time.Duration) {
+	d.Attempts++
+	d.LastError = reason
+
+	now := time.Now()
+	d.NextAttemptAt = now.Add(retryDelay(d.Attempts, baseDelay, maxDelay))
+	d.UpdatedAt = now
+}
+
+func (d *AccountDeletion) completeIfDone() {
+	if d.ProfileDeletedAt != nil && d.OrdersAnonymizedAt != nil && d.CompletedAt == nil {
+		completedAt := d.UpdatedAt
+		d.CompletedAt = &completedAt
+		d.LastError = ""
+	}
+}
+
+// retryDelay returns the delay after the given number of failed attempts:
+// baseDelay after the first, doubling up to maxDelay.
+func retryDelay(attempts int, baseDelay, maxDelay time.Duration) time.Duration {
+	delay := baseDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
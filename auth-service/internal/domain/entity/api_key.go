@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ScopeProfileRead  = "profile.read"
+	ScopeProfileWrite = "profile.write"
+	ScopeOrdersRead   = "orders.read"
+	ScopeOrdersWrite  = "orders.write"
+)
+
+// APIKeyScopes are the scopes a personal API key may be created with. Each
+// service maps its RPCs to one of them; RPCs without a scope cannot be
+// called with an API key.
+var APIKeyScopes = []string{ScopeProfileRead, ScopeProfileWrite, ScopeOrdersRead, ScopeOrdersWrite}
+
+// APIKey is a long-lived credential a user creates for scripts, sent as
+// "Authorization: ApiKey <key>". It acts as its owner but only for the
+// scopes it was created with. Only the hash of the key is stored; Prefix is
+// its first characters, kept so the user can tell keys apart.
+type APIKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func NewAPIKey(userID uuid.UUID, name, prefix, keyHash string, scopes []string, expiresAt time.Time) *APIKey {
+	return &APIKey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *APIKey) IsExpired() bool {
+	return time.Now().After(k.ExpiresAt)
+}
+
+func (k *APIKey) IsValid() bool {
+	return !k.IsRevoked() && !k.IsExpired()
+}
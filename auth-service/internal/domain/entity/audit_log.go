@@ -27,6 +27,48 @@ const (
 	AuditActionPasswordChange  AuditAction = "password_change"
 	AuditActionAccountLocked   AuditAction = "account_locked"
 	AuditActionAccountVerified AuditAction = "account_verified"
+
+	AuditActionPasswordResetRequested AuditAction = "password_reset_requested"
+	AuditActionPasswordReset          AuditAction = "password_reset"
+
+	AuditActionMFAEnabled         AuditAction = "mfa_enabled"
+	AuditActionMFADisabled        AuditAction = "mfa_disabled"
+	AuditActionMFAChallengeFailed AuditAction = "mfa_challenge_failed"
+	AuditActionRecoveryCodeUsed   AuditAction = "recovery_code_used"
+
+	AuditActionTokenReuseDetected AuditAction = "token_reuse_detected"
+
+	AuditActionSessionRevoked AuditAction = "session_revoked"
+
+	AuditActionSigningKeyRotated AuditAction = "signing_key_rotated"
+
+	AuditActionUserLocked          AuditAction = "user_locked"
+	AuditActionUserUnlocked        AuditAction = "user_unlocked"
+	AuditActionUserActivated       AuditAction = "user_activated"
+	AuditActionUserDeactivated     AuditAction = "user_deactivated"
+	AuditActionUserRoleChanged     AuditAction = "user_role_changed"
+	AuditActionPasswordResetForced AuditAction = "password_reset_forced"
+
+	AuditActionOAuthClientCreated  AuditAction = "oauth_client_created"
+	AuditActionOAuthClientDeleted  AuditAction = "oauth_client_deleted"
+	AuditActionOAuthConsentGranted AuditAction = "oauth_consent_granted"
+	AuditActionOAuthConsentRevoked AuditAction = "oauth_consent_revoked"
+
+	AuditActionAPIKeyCreated AuditAction = "api_key_created"
+	AuditActionAPIKeyRevoked AuditAction = "api_key_revoked"
+
+	AuditActionNewDeviceLogin            AuditAction = "new_device_login"
+	AuditActionUnrecognizedLoginReported AuditAction = "unrecognized_login_reported"
+	AuditActionLoginStepUpFailed         AuditAction = "login_step_up_failed"
+
+	AuditActionAccountDeleted           AuditAction = "account_deleted"
+	AuditActionAccountDeletionCompleted AuditAction = "account_deletion_completed"
+
+	AuditActionDataExportRequested  AuditAction = "data_export_requested"
+	AuditActionDataExportDownloaded AuditAction = "data_export_downloaded"
+
+	AuditActionEmailChangeRequested AuditAction = "email_change_requested"
+	AuditActionEmailChanged         AuditAction = "email_changed"
 )
 
 func NewAuditLog(userID uuid.UUID, action AuditAction, ipAddress, userAgent string) *AuditLog {
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPending DataExportStatus = "pending"
+	DataExportStatusReady   DataExportStatus = "ready"
+	DataExportStatusFailed  DataExportStatus = "failed"
+)
+
+// DataExport is a user's request for a copy of their personal data. It is
+// built in the background into a ZIP archive, which can be downloaded until
+// ExpiresAt; failed exports expire too and the user requests a new one.
+type DataExport struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Status      DataExportStatus
+	SizeBytes   int64
+	Error       string
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewDataExport(userID uuid.UUID) *DataExport {
+	now := time.Now()
+	return &DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    DataExportStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (e *DataExport) IsPending() bool {
+	return e.Status == DataExportStatusPending
+}
+
+func (e *DataExport) IsExpired() bool {
+	return e.ExpiresAt != nil && time.Now().After(*e.ExpiresAt)
+}
+
+// Complete marks the export ready with an archive of sizeBytes, downloadable
+// for ttl.
+func (e *DataExport) Complete(sizeBytes int64, ttl time.Duration) {
+	e.Status = DataExportStatusReady
+	e.SizeBytes = sizeBytes
+	e.finish(ttl)
+}
+
+func (e *DataExport) Fail(reason string, ttl time.Duration) {
+	e.Status = DataExportStatusFailed
+	e.Error = reason
+	e.finish(ttl)
+}
+
+func (e *DataExport) finish(ttl time.Duration) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	e.UpdatedAt = now
+}
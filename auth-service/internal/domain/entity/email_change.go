@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChange is a user's request to move their account to NewEmail. It
+// takes effect when the token mailed to NewEmail is confirmed before
+// ExpiresAt. The user-service keeps a copy of the email on the profile, so
+// a confirmed change is then synced to it by a background job, which backs
+// off between failed attempts.
+type EmailChange struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	OldEmail      string
+	NewEmail      string
+	TokenHash     string
+	ExpiresAt     time.Time
+	ConfirmedAt   *time.Time
+	SyncedAt      *time.Time
+	SyncAttempts  int
+	LastSyncError string
+	NextSyncAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewEmailChange(userID uuid.UUID, oldEmail, newEmail, tokenHash string, expiresAt time.Time) *EmailChange {
+	now := time.Now()
+	return &EmailChange{
+		ID:        uuid.New(),
+		UserID:    userID,
+		OldEmail:  oldEmail,
+		NewEmail:  newEmail,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsPending reports whether the change can still be confirmed.
+func (c *EmailChange) IsPending() bool {
+	return c.ConfirmedAt == nil && time.Now().Before(c.ExpiresAt)
+}
+
+// Confirm marks the change applied and due to be synced at once.
+func (c *EmailChange) Confirm() {
+	now := time.Now()
+	c.ConfirmedAt = &now
+	c.NextSyncAt = &now
+	c.UpdatedAt = now
+}
+
+func (c *EmailChange) MarkSynced() {
+	now := time.Now()
+	c.SyncedAt = &now
+	c.NextSyncAt = nil
+	c.LastSyncError = ""
+	c.UpdatedAt = now
+}
+
+// FailSync records a failed sync and schedules the next one like
+// AccountDeletion.Fail.
+func (c *EmailChange) FailSync(reason string, baseDelay, maxDelay time.Duration) {
+	c.SyncAttempts++
+	c.LastSyncError = reason
+
+	now := time.Now()
+	nextSyncAt := now.Add(retryDelay(c.SyncAttempts, baseDelay, maxDelay))
+	c.NextSyncAt = &nextSyncAt
+	c.UpdatedAt = now
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KnownDevice is a device and network a user has logged in from. Logins
+// whose device or network matches none of the user's known devices are
+// reported to the user.
+type KnownDevice struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Fingerprint string
+	IPPrefix    string
+	// UserAgent is the latest user agent seen with this fingerprint, kept
+	// for display.
+	UserAgent   string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+func NewKnownDevice(userID uuid.UUID, ipAddress, userAgent string) *KnownDevice {
+	now := time.Now()
+	return &KnownDevice{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Fingerprint: DeviceFingerprint(userAgent),
+		IPPrefix:    NetworkPrefix(ipAddress),
+		UserAgent:   userAgent,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	}
+}
+
+var versionPattern = regexp.MustCompile(`\d+(\.\d+)*`)
+
+// DeviceFingerprint identifies the browser or client behind a user agent.
+// Version numbers are dropped so updates do not make a device look new.
+func DeviceFingerprint(userAgent string) string {
+	normalized := strings.ToLower(versionPattern.ReplaceAllString(userAgent, ""))
+	hash := sha256.Sum256([]byte(strings.Join(strings.Fields(normalized), " ")))
+	return hex.EncodeToString(hash[:])
+}
+
+// NetworkPrefix returns the /24 (IPv4) or /48 (IPv6) network of an address,
+// so a new address from the same provider is not a new network. For a
+// forwarded-for list the last hop is used. It is empty for addresses that
+// do not parse.
+func NetworkPrefix(ipAddress string) string {
+	hops := strings.Split(ipAddress, ",")
+	ip := net.ParseIP(strings.TrimSpace(hops[len(hops)-1]))
+	if ip == nil {
+		return ""
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return (&net.IPNet{IP: ip4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}).String()
+}
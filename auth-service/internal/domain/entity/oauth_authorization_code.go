@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthAuthorizationCode is the short-lived, single-use code handed to the
+// client through the redirect. It is bound to the client, the redirect URI
+// and the PKCE code challenge (S256 only). Once exchanged it records the
+// session (refresh-token family) it started, so that a replayed code can
+// revoke the tokens it issued.
+type OAuthAuthorizationCode struct {
+	ID            uuid.UUID
+	CodeHash      string
+	ClientID      uuid.UUID
+	UserID        uuid.UUID
+	RedirectURI   string
+	Scopes        []string
+	CodeChallenge string
+	Nonce         string
+	ExpiresAt     time.Time
+	UsedAt        *time.Time
+	SessionID     *uuid.UUID
+	CreatedAt     time.Time
+}
+
+func NewOAuthAuthorizationCode(codeHash string, clientID, userID uuid.UUID, redirectURI string, scopes []string, codeChallenge, nonce string, expiresAt time.Time) *OAuthAuthorizationCode {
+	return &OAuthAuthorizationCode{
+		ID:            uuid.New(),
+		CodeHash:      codeHash,
+		ClientID:      clientID,
+		UserID:        userID,
+		RedirectURI:   redirectURI,
+		Scopes:        scopes,
+		CodeChallenge: codeChallenge,
+		Nonce:         nonce,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (c *OAuthAuthorizationCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
+
+func (c *OAuthAuthorizationCode) IsValid() bool {
+	if c.IsUsed() {
+		return false
+	}
+	return time.Now().Before(c.ExpiresAt)
+}
+
+// VerifyPKCE checks the code verifier against the S256 code challenge
+// (RFC 7636).
+func (c *OAuthAuthorizationCode) VerifyPKCE(codeVerifier string) bool {
+	if codeVerifier == "" {
+		return false
+	}
+	hash := sha256.Sum256([]byte(codeVerifier))
+	challenge := base64.RawURLEncoding.EncodeToString(hash[:])
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(c.CodeChallenge)) == 1
+}
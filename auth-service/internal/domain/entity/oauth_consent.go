@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthConsent records the scopes a user has granted to a client. The
+// consent screen is shown again only when a client asks for more.
+type OAuthConsent struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	ClientID  uuid.UUID
+	Scopes    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewOAuthConsent(userID, clientID uuid.UUID, scopes []string) *OAuthConsent {
+	now := time.Now()
+	return &OAuthConsent{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ClientID:  clientID,
+		Scopes:    scopes,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (c *OAuthConsent) Covers(scopes []string) bool {
+	return ContainsScopes(c.Scopes, scopes)
+}
+
+// Grant adds scopes to the consent; scopes granted earlier are kept.
+func (c *OAuthConsent) Grant(scopes []string) {
+	for _, s := range scopes {
+		if !HasScope(c.Scopes, s) {
+			c.Scopes = append(c.Scopes, s)
+		}
+	}
+	c.UpdatedAt = time.Now()
+}
@@ -15,18 +15,35 @@ type RefreshToken struct {
 	IsRevoked     bool
 	CreatedAt     time.Time
 	RevokedAt     *time.Time
+	ReplacedByID  *uuid.UUID
+
+	// Device metadata of the session (token family). IPAddress and UserAgent
+	// are those of the request that issued this token, so the newest token
+	// of a family describes where the session was last used.
+	IPAddress        string
+	UserAgent        string
+	SessionStartedAt time.Time
+
+	// ClientID and Scope are set for tokens issued to an OAuth client; such
+	// tokens can only be refreshed by that client at the token endpoint.
+	ClientID *uuid.UUID
+	Scope    string
 }
 
-func NewRefreshToken(userID uuid.UUID, tokenHash string, expiresAt time.Time) *RefreshToken {
+func NewRefreshToken(userID uuid.UUID, tokenHash string, expiresAt time.Time, ipAddress, userAgent string) *RefreshToken {
 	familyID := uuid.New()
+	now := time.Now()
 	return &RefreshToken{
-		ID:            uuid.New(),
-		UserID:        userID,
-		TokenHash:     tokenHash,
-		TokenFamilyID: familyID,
-		ExpiresAt:     expiresAt,
-		IsRevoked:     false,
-		CreatedAt:     time.Now(),
+		ID:               uuid.New(),
+		UserID:           userID,
+		TokenHash:        tokenHash,
+		TokenFamilyID:    familyID,
+		ExpiresAt:        expiresAt,
+		IsRevoked:        false,
+		CreatedAt:        now,
+		IPAddress:        ipAddress,
+		UserAgent:        userAgent,
+		SessionStartedAt: now,
 	}
 }
 
@@ -42,9 +59,43 @@ func NewRefreshTokenWithFamily(userID uuid.UUID, tokenHash string, expiresAt tim
 	}
 }
 
+// Successor creates the token that replaces rt when it is rotated. It stays
+// in the same family and keeps the session start time.
+func (rt *RefreshToken) Successor(tokenHash string, expiresAt time.Time, ipAddress, userAgent string) *RefreshToken {
+	next := NewRefreshTokenWithFamily(rt.UserID, tokenHash, expiresAt, rt.TokenFamilyID)
+	next.IPAddress = ipAddress
+	next.UserAgent = userAgent
+	next.SessionStartedAt = rt.SessionStartedAt
+	next.ClientID = rt.ClientID
+	next.Scope = rt.Scope
+	if next.SessionStartedAt.IsZero() {
+		next.SessionStartedAt = rt.CreatedAt
+	}
+	return next
+}
+
+// IssuedToClient reports whether the token belongs to the given OAuth client.
+func (rt *RefreshToken) IssuedToClient(clientID uuid.UUID) bool {
+	return rt.ClientID != nil && *rt.ClientID == clientID
+}
+
 func (rt *RefreshToken) IsValid() bool {
 	if rt.IsRevoked {
 		return false
 	}
 	return time.Now().Before(rt.ExpiresAt)
 }
+
+// IsRotated reports whether the token was revoked because it was exchanged
+// for a newer token in the same family, as opposed to an explicit logout.
+func (rt *RefreshToken) IsRotated() bool {
+	return rt.IsRevoked && rt.ReplacedByID != nil
+}
+
+// RotatedWithin reports whether the token was rotated less than window ago.
+func (rt *RefreshToken) RotatedWithin(window time.Duration) bool {
+	if !rt.IsRotated() || rt.RevokedAt == nil {
+		return false
+	}
+	return time.Since(*rt.RevokedAt) <= window
+}
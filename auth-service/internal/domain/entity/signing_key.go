@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+type SigningKey struct {
+	ID            string
+	Algorithm     string
+	Status        KeyStatus
+	PrivateKeyPEM string
+	PublicKeyPEM  string
+	CreatedAt     time.Time
+	ActivatedAt   *time.Time
+	RetiresAt     *time.Time
+}
+
+type KeyStatus string
+
+const (
+	KeyStatusNext    KeyStatus = "next"
+	KeyStatusActive  KeyStatus = "active"
+	KeyStatusRetired KeyStatus = "retired"
+)
+
+// NewSigningKey creates a key that is published for verification but not yet
+// used for signing. privateKeyPEM is expected to be encrypted already.
+func NewSigningKey(id, algorithm, privateKeyPEM, publicKeyPEM string) *SigningKey {
+	return &SigningKey{
+		ID:            id,
+		Algorithm:     algorithm,
+		Status:        KeyStatusNext,
+		PrivateKeyPEM: privateKeyPEM,
+		PublicKeyPEM:  publicKeyPEM,
+		CreatedAt:     time.Now(),
+	}
+}
+
+func (k *SigningKey) Activate() {
+	now := time.Now()
+	k.Status = KeyStatusActive
+	k.ActivatedAt = &now
+}
+
+// Retire stops the key from signing. It stays published for gracePeriod so
+// tokens it already signed keep verifying until they expire.
+func (k *SigningKey) Retire(gracePeriod time.Duration) {
+	retiresAt := time.Now().Add(gracePeriod)
+	k.Status = KeyStatusRetired
+	k.RetiresAt = &retiresAt
+}
+
+// IsRetired reports whether the key must no longer be published.
+func (k *SigningKey) IsRetired() bool {
+	if k.Status != KeyStatusRetired {
+		return false
+	}
+	return k.RetiresAt == nil || !time.Now().Before(*k.RetiresAt)
+}
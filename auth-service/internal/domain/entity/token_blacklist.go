@@ -21,3 +21,16 @@ func NewTokenBlacklist(tokenHash string, expiresAt time.Time) *TokenBlacklist {
 		CreatedAt: time.Now(),
 	}
 }
+
+// UserRevocationKey is the TokenHash under which every access token of a
+// user is revoked. Token hashes are base64, so it cannot clash with one.
+func UserRevocationKey(userID uuid.UUID) string {
+	return "user:" + userID.String()
+}
+
+// NewUserRevocation revokes every access token of the user until expiresAt,
+// by which time all of them have expired. It travels the revocation feed
+// like a token hash.
+func NewUserRevocation(userID uuid.UUID, expiresAt time.Time) *TokenBlacklist {
+	return NewTokenBlacklist(UserRevocationKey(userID), expiresAt)
+}
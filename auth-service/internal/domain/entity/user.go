@@ -17,8 +17,16 @@ type User struct {
 	LockedUntil         *time.Time
 	LastLoginAt         *time.Time
 	LastLoginIP         string
-	CreatedAt           time.Time
-	UpdatedAt           time.Time
+	MFAEnabled          bool
+	MFASecret           string
+	MFALastUsedStep     int64
+	// PasswordResetRequired blocks login until the password is reset.
+	PasswordResetRequired bool
+	// PasswordChangedAt is nil for accounts that predate it; their password
+	// counts as set when the account was created.
+	PasswordChangedAt *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 type Role string
@@ -31,17 +39,26 @@ const (
 func NewUser(email, passwordHash string) *User {
 	now := time.Now()
 	return &User{
-		ID:           uuid.New(),
-		Email:        email,
-		PasswordHash: passwordHash,
-		Role:         RoleUser,
-		IsVerified:   false,
-		IsActive:     true,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		ID:                uuid.New(),
+		Email:             email,
+		PasswordHash:      passwordHash,
+		Role:              RoleUser,
+		IsVerified:        false,
+		IsActive:          true,
+		PasswordChangedAt: &now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
 	}
 }
 
+func (r Role) IsValid() bool {
+	return r == RoleUser || r == RoleAdmin
+}
+
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
 func (u *User) IsAccountLocked() bool {
 	if u.LockedUntil == nil {
 		return false
@@ -65,6 +82,16 @@ func (u *User) ResetFailedLoginAttempts() {
 	u.UpdatedAt = time.Now()
 }
 
+// Lock blocks login until the given time, like an automatic lockout.
+func (u *User) Lock(until time.Time) {
+	u.LockedUntil = &until
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) Unlock() {
+	u.ResetFailedLoginAttempts()
+}
+
 func (u *User) UpdateLastLogin(ipAddress string) {
 	now := time.Now()
 	u.LastLoginAt = &now
@@ -73,7 +100,36 @@ func (u *User) UpdateLastLogin(ipAddress string) {
 }
 
 func (u *User) UpdatePassword(passwordHash string) {
+	now := time.Now()
 	u.PasswordHash = passwordHash
+	u.PasswordResetRequired = false
+	u.PasswordChangedAt = &now
+	u.UpdatedAt = now
+}
+
+// PasswordExpired reports whether the password was set more than maxAge ago.
+func (u *User) PasswordExpired(maxAge time.Duration) bool {
+	changedAt := u.CreatedAt
+	if u.PasswordChangedAt != nil {
+		changedAt = *u.PasswordChangedAt
+	}
+	return time.Since(changedAt) > maxAge
+}
+
+// RehashPassword replaces the hash of the unchanged password, e.g. with one
+// of a stronger algorithm. Unlike UpdatePassword it is not a password change.
+func (u *User) RehashPassword(passwordHash string) {
+	u.PasswordHash = passwordHash
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) RequirePasswordReset() {
+	u.PasswordResetRequired = true
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) ChangeRole(role Role) {
+	u.Role = role
 	u.UpdatedAt = time.Now()
 }
 
@@ -82,6 +138,14 @@ func (u *User) Verify() {
 	u.UpdatedAt = time.Now()
 }
 
+// ChangeEmail replaces the email with a new address the user has confirmed
+// they own, so the account stays verified.
+func (u *User) ChangeEmail(email string) {
+	u.Email = email
+	u.IsVerified = true
+	u.UpdatedAt = time.Now()
+}
+
 func (u *User) Deactivate() {
 	u.IsActive = false
 	u.UpdatedAt = time.Now()
@@ -91,3 +155,49 @@ func (u *User) Activate() {
 	u.IsActive = true
 	u.UpdatedAt = time.Now()
 }
+
+// SetMFASecret stores a new, not yet confirmed, encrypted TOTP secret.
+func (u *User) SetMFASecret(encryptedSecret string) {
+	u.MFASecret = encryptedSecret
+	u.MFAEnabled = false
+	u.MFALastUsedStep = 0
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) EnableMFA() {
+	u.MFAEnabled = true
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) DisableMFA() {
+	u.MFAEnabled = false
+	u.MFASecret = ""
+	u.MFALastUsedStep = 0
+	u.UpdatedAt = time.Now()
+}
+
+// UseMFAStep records a TOTP time step as consumed. It returns false if the
+// step (or a later one) was already used, which means the code is a replay.
+func (u *User) UseMFAStep(step int64) bool {
+	if step <= u.MFALastUsedStep {
+		return false
+	}
+	u.MFALastUsedStep = step
+	u.UpdatedAt = time.Now()
+	return true
+}
+
+// Erase removes the personal data of a user whose account is being deleted
+// and deactivates it. The email is replaced with a placeholder unique to the
+// account, so the address can be registered again.
+func (u *User) Erase() {
+	u.Email = "deleted-" + u.ID.String() + "@deleted.invalid"
+	u.PasswordHash = ""
+	u.IsActive = false
+	u.LastLoginAt = nil
+	u.LastLoginIP = ""
+	u.MFAEnabled = false
+	u.MFASecret = ""
+	u.MFALastUsedStep = 0
+	u.UpdatedAt = time.Now()
+}
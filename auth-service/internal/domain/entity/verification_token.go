@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VerificationToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	Purpose   TokenPurpose
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type TokenPurpose string
+
+const (
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+	TokenPurposePasswordReset     TokenPurpose = "password_reset"
+	TokenPurposeMFAChallenge      TokenPurpose = "mfa_pending"
+	TokenPurposePasswordChange    TokenPurpose = "password_change"
+	TokenPurposeUnrecognizedLogin TokenPurpose = "unrecognized_login"
+	TokenPurposeLoginStepUp       TokenPurpose = "login_step_up"
+	TokenPurposeLoginStepUpCode   TokenPurpose = "login_step_up_code"
+)
+
+func NewVerificationToken(userID uuid.UUID, tokenHash string, purpose TokenPurpose, expiresAt time.Time) *VerificationToken {
+	return &VerificationToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		Purpose:   purpose,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *VerificationToken) IsValid() bool {
+	if t.UsedAt != nil {
+		return false
+	}
+	return time.Now().Before(t.ExpiresAt)
+}
@@ -9,17 +9,51 @@ var (
 	ErrInvalidPassword    = errors.New("invalid password")
 	ErrWeakPassword       = errors.New("password is too weak")
 	
-	ErrAccountLocked   = errors.New("account is locked")
-	ErrAccountInactive = errors.New("account is inactive")
+	ErrAccountLocked    = errors.New("account is locked")
+	ErrAccountInactive  = errors.New("account is inactive")
+	ErrEmailNotVerified = errors.New("email is not verified")
+
+	ErrPasswordResetRequired = errors.New("password reset required")
+
+	ErrInvalidMFACode    = errors.New("invalid mfa code")
+	ErrMFAAlreadyEnabled = errors.New("mfa is already enabled")
+	ErrMFANotEnabled     = errors.New("mfa is not enabled")
+	ErrMFANotEnrolled    = errors.New("mfa enrollment has not been started")
+
+	ErrInvalidStepUpCode = errors.New("invalid verification code")
 	
 	ErrInvalidToken   = errors.New("invalid token")
 	ErrTokenExpired   = errors.New("token expired")
 	ErrTokenRevoked   = errors.New("token revoked")
 	ErrMissingToken   = errors.New("missing token")
+
+	ErrTokenReuseDetected = errors.New("refresh token reuse detected")
+	ErrSessionNotFound    = errors.New("session not found")
 	
 	ErrInvalidInput    = errors.New("invalid input")
 	ErrValidationError = errors.New("validation error")
 	
+	ErrForbidden        = errors.New("forbidden")
+	ErrCannotModifySelf = errors.New("admins cannot perform this action on their own account")
+
+	ErrKeyRotationConflict = errors.New("signing key was rotated concurrently")
+	ErrNoActiveSigningKey  = errors.New("no active signing key")
+
+	ErrOAuthClientNotFound  = errors.New("oauth client not found")
+	ErrOAuthConsentNotFound = errors.New("oauth consent not found")
+	ErrInvalidClient        = errors.New("invalid client")
+	ErrUnauthorizedClient   = errors.New("client is not allowed to use this grant type")
+	ErrInvalidGrant         = errors.New("invalid grant")
+	ErrInvalidRedirectURI   = errors.New("redirect uri is not registered for the client")
+	ErrInvalidScope         = errors.New("invalid scope")
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")
+
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	ErrDataExportNotFound = errors.New("data export not found")
+	ErrDataExportNotReady = errors.New("data export is not ready")
+	ErrDataExportExpired  = errors.New("data export has expired")
+
 	ErrInternalServer = errors.New("internal server error")
 	ErrDatabase       = errors.New("database error")
 )
@@ -0,0 +1,40 @@
+package errors
+
+import "strings"
+
+// Password policy rules, as reported in PasswordViolation.Rule.
+const (
+	PasswordRuleMinLength     = "min_length"
+	PasswordRuleMaxLength     = "max_length"
+	PasswordRuleUppercase     = "uppercase"
+	PasswordRuleLowercase     = "lowercase"
+	PasswordRuleDigit         = "digit"
+	PasswordRuleSpecial       = "special"
+	PasswordRuleMaxRepeats    = "max_repeats"
+	PasswordRuleContainsEmail = "contains_email"
+	PasswordRuleBreached      = "breached"
+	PasswordRuleReused        = "reused"
+)
+
+type PasswordViolation struct {
+	Rule    string
+	Message string
+}
+
+// PasswordPolicyError lists every password policy rule a password breaks. It
+// wraps ErrWeakPassword.
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return ErrWeakPassword.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
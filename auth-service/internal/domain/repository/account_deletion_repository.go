@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+)
+
+type AccountDeletionRepository interface {
+	// Start deletes the account and records deletion in one transaction:
+	// user, already erased with User.Erase, is saved and soft-deleted, and
+	// its sessions, tokens, API keys, recovery codes, password history,
+	// known devices, OAuth consents, data exports and email changes are
+	// removed, and revocation is added to the token blacklist. Audit logs
+	// are kept until they age out. It returns ErrUserNotFound if the user is
+	// already deleted.
+	Start(ctx context.Context, user *entity.User, deletion *entity.AccountDeletion, revocation *entity.TokenBlacklist) error
+	// ListDue returns up to limit deletions that are not completed and whose
+	// next attempt is due at now, oldest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.AccountDeletion, error)
+	Update(ctx context.Context, deletion *entity.AccountDeletion) error
+}
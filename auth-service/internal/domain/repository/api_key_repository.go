@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entity.APIKey) error
+	// FindByKeyHash returns ErrAPIKeyNotFound for unknown keys. Revoked and
+	// expired keys are returned; the caller checks them.
+	FindByKeyHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	// ListByUserID returns the user's keys that are not revoked, newest
+	// first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.APIKey, error)
+	// Revoke returns ErrAPIKeyNotFound unless the key belongs to userID and
+	// is not already revoked.
+	Revoke(ctx context.Context, id, userID uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"auth-service/internal/domain/entity"
 
@@ -11,5 +12,23 @@ import (
 type AuditLogRepository interface {
 	Create(ctx context.Context, log *entity.AuditLog) error
 	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.AuditLog, error)
-	DeleteOlderThan(ctx context.Context, days int) error
+	// Search returns entries matching filter, newest first, starting after
+	// the given cursor (nil for the first page).
+	Search(ctx context.Context, filter AuditLogFilter, after *AuditLogCursor, limit int) ([]*entity.AuditLog, error)
+	DeleteOlderThan(ctx context.Context, days int) (int64, error)
+}
+
+// AuditLogFilter narrows a search. Zero-valued fields are ignored.
+type AuditLogFilter struct {
+	UserID    *uuid.UUID
+	Actions   []entity.AuditAction
+	IPAddress string
+	From      *time.Time
+	To        *time.Time
+}
+
+// AuditLogCursor identifies the last entry of a page for keyset pagination.
+type AuditLogCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
 }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type DataExportRepository interface {
+	Create(ctx context.Context, export *entity.DataExport) error
+	// FindByID returns the export without its archive. It returns
+	// ErrDataExportNotFound if there is none.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error)
+	// FindPendingByUserID returns the user's export that is still being
+	// built, or nil if there is none.
+	FindPendingByUserID(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error)
+	// ListPending returns up to limit exports waiting to be built, oldest
+	// first.
+	ListPending(ctx context.Context, limit int) ([]*entity.DataExport, error)
+	// Update saves the export and, if archive is not nil, its archive.
+	Update(ctx context.Context, export *entity.DataExport, archive []byte) error
+	// FindArchive returns the archive of a ready export.
+	FindArchive(ctx context.Context, id uuid.UUID) ([]byte, error)
+	// DeleteExpired removes exports, with their archives, that expired
+	// before now.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+)
+
+type EmailChangeRepository interface {
+	// Create stores change and discards the user's other unconfirmed
+	// changes, so only the latest link works.
+	Create(ctx context.Context, change *entity.EmailChange) error
+	// FindByTokenHash returns ErrInvalidToken if no change has the token.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailChange, error)
+	// Confirm applies change in one transaction: change, already confirmed,
+	// is saved and the email and verification of user, already moved with
+	// User.ChangeEmail, are written. It returns ErrInvalidToken if the change
+	// was confirmed concurrently or the user is deleted or no longer has the
+	// old email, and ErrUserAlreadyExists if another account has the new
+	// email in any letter case.
+	Confirm(ctx context.Context, user *entity.User, change *entity.EmailChange) error
+	// ListUnsynced returns up to limit confirmed changes that are not synced
+	// yet and whose next sync is due at now, oldest first.
+	ListUnsynced(ctx context.Context, now time.Time, limit int) ([]*entity.EmailChange, error)
+	Update(ctx context.Context, change *entity.EmailChange) error
+	// DeleteFinished removes the changes that expired unconfirmed before now
+	// and those already synced.
+	DeleteFinished(ctx context.Context, now time.Time) (int64, error)
+}
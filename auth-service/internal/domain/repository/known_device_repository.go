@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type KnownDeviceRepository interface {
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.KnownDevice, error)
+	// Upsert stores the device, or updates LastSeenAt and UserAgent of the
+	// user's device with the same fingerprint and IP prefix.
+	Upsert(ctx context.Context, device *entity.KnownDevice) error
+	Delete(ctx context.Context, userID uuid.UUID, fingerprint, ipPrefix string) error
+	// DeleteNotSeenSince removes devices last seen before the given time and
+	// returns how many were deleted.
+	DeleteNotSeenSince(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type OAuthAuthorizationCodeRepository interface {
+	Create(ctx context.Context, code *entity.OAuthAuthorizationCode) error
+	FindByCodeHash(ctx context.Context, codeHash string) (*entity.OAuthAuthorizationCode, error)
+	// MarkUsed consumes the code and records the session it started. It
+	// returns ErrInvalidGrant if the code was already consumed.
+	MarkUsed(ctx context.Context, id, sessionID uuid.UUID) error
+	// DeleteExpired removes rows that expired before the given time and
+	// returns how many were deleted.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
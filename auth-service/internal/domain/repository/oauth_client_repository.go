@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type OAuthClientRepository interface {
+	Create(ctx context.Context, client *entity.OAuthClient) error
+	// Save creates client or, if one with its ID exists, updates it; the
+	// creator and creation time are kept.
+	Save(ctx context.Context, client *entity.OAuthClient) error
+	// FindByID returns ErrOAuthClientNotFound if the client does not exist.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.OAuthClient, error)
+	List(ctx context.Context) ([]*entity.OAuthClient, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
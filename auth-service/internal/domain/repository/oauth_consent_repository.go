@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type OAuthConsentRepository interface {
+	// Find returns ErrOAuthConsentNotFound if the user never consented to
+	// the client.
+	Find(ctx context.Context, userID, clientID uuid.UUID) (*entity.OAuthConsent, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.OAuthConsent, error)
+	Save(ctx context.Context, consent *entity.OAuthConsent) error
+	Delete(ctx context.Context, userID, clientID uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type PasswordHistoryRepository interface {
+	Create(ctx context.Context, entry *entity.PasswordHistory) error
+	// ListRecent returns the user's newest limit entries, newest first.
+	ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.PasswordHistory, error)
+	// Prune deletes all but the user's newest keep entries.
+	Prune(ctx context.Context, userID uuid.UUID, keep int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository interface {
+	ReplaceAll(ctx context.Context, userID uuid.UUID, codes []*entity.RecoveryCode) error
+	Consume(ctx context.Context, userID uuid.UUID, codeHash string) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
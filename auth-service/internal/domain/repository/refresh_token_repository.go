@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"auth-service/internal/domain/entity"
 
@@ -15,5 +16,10 @@ type RefreshTokenRepository interface {
 	RevokeByTokenHash(ctx context.Context, tokenHash string) error
 	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
 	RevokeByTokenFamilyID(ctx context.Context, familyID uuid.UUID) error
-	DeleteExpired(ctx context.Context) error
+	// RevokeByClientID revokes the user's tokens issued to an OAuth client.
+	RevokeByClientID(ctx context.Context, userID, clientID uuid.UUID) error
+	MarkRotated(ctx context.Context, id, replacedByID uuid.UUID) error
+	// DeleteExpired removes rows that expired before the given time and
+	// returns how many were deleted.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
 }
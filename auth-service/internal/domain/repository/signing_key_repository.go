@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+)
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, key *entity.SigningKey) error
+	FindAll(ctx context.Context) ([]*entity.SigningKey, error)
+	// Rotate atomically retires activeID, promotes nextID and stores newNext.
+	// It fails with ErrKeyRotationConflict if either key has changed state,
+	// e.g. because another replica rotated first.
+	Rotate(ctx context.Context, activeID, nextID string, retiresAt time.Time, newNext *entity.SigningKey) error
+	DeleteRetiredBefore(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ThrottleWindow summarises the events of one throttle key since a point in
+// time. First and Last are zero when Count is 0.
+type ThrottleWindow struct {
+	Count int
+	First time.Time
+	Last  time.Time
+}
+
+// ThrottleKey names a throttle key and the start of the window its events
+// are counted in.
+type ThrottleKey struct {
+	Key   string
+	Since time.Time
+}
+
+// ThrottleRepository records the attempts that login and registration
+// throttling counts, under keys such as "login:ip:<address>".
+type ThrottleRepository interface {
+	// Attempt passes the windows of keys, in order, to decide. If decide
+	// returns no wait, an event at at is recorded under every key, tagged
+	// with attemptID. Reading the windows and recording are atomic, so
+	// concurrent attempts on the same keys count each other.
+	Attempt(ctx context.Context, attemptID uuid.UUID, keys []ThrottleKey, at time.Time, decide func([]ThrottleWindow) time.Duration) (time.Duration, error)
+	// Forget deletes the events of an attempt that turned out not to count.
+	Forget(ctx context.Context, attemptID uuid.UUID) error
+	Clear(ctx context.Context, key string) error
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"auth-service/internal/domain/entity"
 )
@@ -9,5 +10,10 @@ import (
 type TokenBlacklistRepository interface {
 	Add(ctx context.Context, blacklist *entity.TokenBlacklist) error
 	IsBlacklisted(ctx context.Context, tokenHash string) (bool, error)
-	DeleteExpired(ctx context.Context) error
+	// FindCreatedSince returns up to limit unexpired entries created at or
+	// after since, oldest first.
+	FindCreatedSince(ctx context.Context, since time.Time, limit int) ([]*entity.TokenBlacklist, error)
+	// DeleteExpired removes rows that expired before the given time and
+	// returns how many were deleted.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
 }
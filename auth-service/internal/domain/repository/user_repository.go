@@ -8,11 +8,28 @@ import (
 	"github.com/google/uuid"
 )
 
+// UserFilter narrows a user search. Empty fields match every user.
+type UserFilter struct {
+	// Query matches a substring of the email, case-insensitively.
+	Query    string
+	Role     *entity.Role
+	IsActive *bool
+	// Locked matches users whose lockout has not expired yet.
+	Locked *bool
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
+	// UseMFAStep records step as the user's last used TOTP time step. It
+	// returns ErrInvalidMFACode if that step or a later one was already
+	// used, so that concurrent requests cannot redeem one code twice.
+	UseMFAStep(ctx context.Context, id uuid.UUID, step int64) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	// List returns one page of matching users, newest first, and the total
+	// number of matches.
+	List(ctx context.Context, filter UserFilter, limit, offset int) ([]*entity.User, int64, error)
 }
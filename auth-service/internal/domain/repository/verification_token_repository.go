@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type VerificationTokenRepository interface {
+	Create(ctx context.Context, token *entity.VerificationToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string, purpose entity.TokenPurpose) (*entity.VerificationToken, error)
+	MarkUsed(ctx context.Context, id uuid.UUID) error
+	InvalidateByUserID(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error
+	// DeleteExpired removes rows that expired before the given time and
+	// returns how many were deleted.
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,18 @@
+package service
+
+import "context"
+
+// LeaderLock elects a single holder for a named lock across all replicas.
+type LeaderLock interface {
+	// TryAcquire returns a lease if this replica became the holder of name,
+	// or ok=false if another replica holds it.
+	TryAcquire(ctx context.Context, name string) (lease LeaderLease, ok bool, err error)
+}
+
+// LeaderLease is held until Release is called or the lock is lost.
+type LeaderLease interface {
+	// Check returns an error if the lock may have been lost, for example
+	// because the database connection holding it was closed.
+	Check(ctx context.Context) error
+	Release()
+}
@@ -0,0 +1,13 @@
+package service
+
+import "context"
+
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type MailSender interface {
+	Send(ctx context.Context, msg MailMessage) error
+}
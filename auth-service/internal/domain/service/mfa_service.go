@@ -0,0 +1,14 @@
+package service
+
+type TOTPService interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(accountName, secret string) string
+	// Validate reports whether code is valid for secret and returns the time
+	// step it matched, so callers can reject replays of the same code.
+	Validate(secret, code string) (step int64, ok bool)
+}
+
+type SecretCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
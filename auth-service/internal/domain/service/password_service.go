@@ -3,5 +3,14 @@ package service
 type PasswordService interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(hashedPassword, password string) error
-	ValidatePasswordStrength(password string) error
+	// NeedsRehash reports whether a stored hash uses an older algorithm or
+	// other cost parameters than HashPassword does now.
+	NeedsRehash(hashedPassword string) bool
+}
+
+// PasswordPolicy decides whether a new password may be used by the account
+// with the given email. It returns a *errors.PasswordPolicyError naming every
+// rule the password breaks.
+type PasswordPolicy interface {
+	Validate(password, email string) error
 }
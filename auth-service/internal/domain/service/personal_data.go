@@ -0,0 +1,66 @@
+package service
+
+import "context"
+
+// ProfileEraser deletes a user's profile held by the user-service. Deleting
+// a profile that does not exist succeeds.
+type ProfileEraser interface {
+	DeleteUserProfile(ctx context.Context, userID string) error
+}
+
+// OrderAnonymizer removes the personal data from a user's orders in the
+// order-service, keeping amounts and items for the financial records.
+// Anonymizing orders twice succeeds.
+type OrderAnonymizer interface {
+	AnonymizeUserOrders(ctx context.Context, userID string) error
+}
+
+// ProfileExporter reads a user's profile from the user-service for a
+// personal data export. It returns nil if the user has no profile.
+type ProfileExporter interface {
+	ExportUserProfile(ctx context.Context, userID string) (*ExportedProfile, error)
+}
+
+// OrderExporter reads all of a user's orders, with their items, from the
+// order-service for a personal data export.
+type OrderExporter interface {
+	ExportUserOrders(ctx context.Context, userID string) ([]*ExportedOrder, error)
+}
+
+// EmailSyncer tells the user-service a user's current email, which it keeps
+// on the profile. Syncing the same email twice succeeds.
+type EmailSyncer interface {
+	SyncUserEmail(ctx context.Context, userID, email string) error
+}
+
+type ExportedProfile struct {
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Phone      string `json:"phone"`
+	Address    string `json:"address"`
+	City       string `json:"city"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postal_code"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type ExportedOrder struct {
+	OrderID            string              `json:"order_id"`
+	Status             string              `json:"status"`
+	TotalAmount        float64             `json:"total_amount"`
+	Items              []ExportedOrderItem `json:"items"`
+	ShippingAddress    string              `json:"shipping_address"`
+	ShippingCity       string              `json:"shipping_city"`
+	ShippingCountry    string              `json:"shipping_country"`
+	ShippingPostalCode string              `json:"shipping_postal_code"`
+	CreatedAt          string              `json:"created_at"`
+	UpdatedAt          string              `json:"updated_at"`
+}
+
+type ExportedOrderItem struct {
+	ProductID   string  `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Quantity    int32   `json:"quantity"`
+	Price       float64 `json:"price"`
+}
@@ -1,21 +1,74 @@
 package service
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type TokenClaims struct {
-	UserID   string
-	Email    string
-	Role     string
-	IssuedAt int64
+	UserID    string
+	Email     string
+	Role      string
+	SessionID string
+	// ClientID and Scope are set for tokens issued to an OAuth client.
+	ClientID  string
+	Scope     string
+	Issuer    string
+	IssuedAt  int64
+	ExpiresAt int64
+}
+
+// IDTokenClaims are the claims of an OpenID Connect ID token. Email is only
+// included when the client was granted the email scope.
+type IDTokenClaims struct {
+	Subject       string
+	Audience      string
+	Nonce         string
+	Email         string
+	EmailVerified bool
+}
+
+// JSONWebKey is the public part of a signing key as published in the JWKS
+// document (RFC 7517). Fields that do not apply to the key type are empty.
+type JSONWebKey struct {
+	KeyID     string
+	KeyType   string
+	Algorithm string
+	Use       string
+	N         string
+	E         string
+	Curve     string
+	X         string
+	Y         string
 }
 
 type TokenService interface {
 	GenerateAccessToken(claims TokenClaims) (string, error)
 	GenerateRefreshToken() (plainToken string, hashedToken string, err error)
-	ExtractClaimsWithoutValidation(token string) (*TokenClaims, error)
+	// GenerateIDToken signs an OpenID Connect ID token with the active key.
+	// Its issuer is the OIDC issuer URL, so it is never accepted as an
+	// access token.
+	GenerateIDToken(claims IDTokenClaims) (string, error)
+	// GenerateServiceToken issues an access token to a machine client, valid
+	// for ttl. Only ClientID, Role and Scope are used; ClientID becomes the
+	// subject and there is no user_id, so ValidateAccessToken rejects it.
+	GenerateServiceToken(claims TokenClaims, ttl time.Duration) (string, error)
+	// ValidateAccessToken verifies the token's signature, expiry, not-before
+	// and issuer, and returns ErrInvalidToken or ErrTokenExpired otherwise.
+	ValidateAccessToken(token string) (*TokenClaims, error)
+	// ValidateServiceToken makes the same checks for a machine client's
+	// token and rejects tokens issued for a user.
+	ValidateServiceToken(token string) (*TokenClaims, error)
 	HashToken(token string) string
 	GetAccessTokenExpiry() time.Duration
 	GetRefreshTokenExpiry() time.Duration
 	GetPublicKey() string
 	GetAlgorithm() string
+	GetKeyID() string
+	GetJWKS() []JSONWebKey
+}
+
+// SigningKeyManager rotates the keys used to sign access tokens.
+type SigningKeyManager interface {
+	Rotate(ctx context.Context) error
 }
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/service"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// OrderClient implements service.OrderAnonymizer and service.OrderExporter
+// with the order-service's internal RPCs.
+type OrderClient struct {
+	conn   *grpc.ClientConn
+	orders proto.OrderServiceClient
+}
+
+func NewOrderClient(addr string, tokens service.TokenService, tokenTTL time.Duration) (*OrderClient, error) {
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(serviceTokenInterceptor(tokens, map[string]string{
+			proto.OrderService_AnonymizeUserOrders_FullMethodName: entity.ScopeOrdersAnonymize,
+			proto.OrderService_ExportUserOrders_FullMethodName:    entity.ScopeOrdersExport,
+		}, tokenTTL)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderClient{
+		conn:   conn,
+		orders: proto.NewOrderServiceClient(conn),
+	}, nil
+}
+
+func (c *OrderClient) AnonymizeUserOrders(ctx context.Context, userID string) error {
+	_, err := c.orders.AnonymizeUserOrders(ctx, &proto.AnonymizeUserOrdersRequest{UserId: userID})
+	return err
+}
+
+func (c *OrderClient) ExportUserOrders(ctx context.Context, userID string) ([]*service.ExportedOrder, error) {
+	resp, err := c.orders.ExportUserOrders(ctx, &proto.ExportUserOrdersRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*service.ExportedOrder, len(resp.GetOrders()))
+	for i, order := range resp.GetOrders() {
+		items := make([]service.ExportedOrderItem, len(order.GetItems()))
+		for j, item := range order.GetItems() {
+			items[j] = service.ExportedOrderItem{
+				ProductID:   item.GetProductId(),
+				ProductName: item.GetProductName(),
+				Quantity:    item.GetQuantity(),
+				Price:       item.GetPrice(),
+			}
+		}
+		orders[i] = &service.ExportedOrder{
+			OrderID:            order.GetOrderId(),
+			Status:             order.GetStatus(),
+			TotalAmount:        order.GetTotalAmount(),
+			Items:              items,
+			ShippingAddress:    order.GetShippingAddress(),
+			ShippingCity:       order.GetShippingCity(),
+			ShippingCountry:    order.GetShippingCountry(),
+			ShippingPostalCode: order.GetShippingPostalCode(),
+			CreatedAt:          order.GetCreatedAt(),
+			UpdatedAt:          order.GetUpdatedAt(),
+		}
+	}
+	return orders, nil
+}
+
+func (c *OrderClient) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// serviceTokenInterceptor authenticates calls as the auth-service itself,
+// a machine client granted only the scope scopes maps the called method to.
+// Signing is local, so every call gets a fresh token valid for ttl instead of
+// one being cached.
+func serviceTokenInterceptor(tokens service.TokenService, scopes map[string]string, ttl time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		scope, ok := scopes[method]
+		if !ok {
+			return status.Errorf(codes.Internal, "no service scope for %s", method)
+		}
+
+		token, err := tokens.GenerateServiceToken(service.TokenClaims{
+			Role:     entity.MachineClientRole,
+			ClientID: entity.AuthServiceClientID,
+			Scope:    scope,
+		}, ttl)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to sign service token: %v", err)
+		}
+
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
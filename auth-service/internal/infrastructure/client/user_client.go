@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	proto "auth-service/gen/go"
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/service"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// UserClient implements service.ProfileEraser, service.ProfileExporter and
+// service.EmailSyncer with the user-service's internal RPCs.
+type UserClient struct {
+	conn  *grpc.ClientConn
+	users proto.UserServiceClient
+}
+
+func NewUserClient(addr string, tokens service.TokenService, tokenTTL time.Duration) (*UserClient, error) {
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(serviceTokenInterceptor(tokens, map[string]string{
+			proto.UserService_DeleteUserData_FullMethodName: entity.ScopeUsersDelete,
+			proto.UserService_ExportUserData_FullMethodName: entity.ScopeUsersExport,
+			proto.UserService_SyncUserEmail_FullMethodName:  entity.ScopeUsersSync,
+		}, tokenTTL)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserClient{
+		conn:  conn,
+		users: proto.NewUserServiceClient(conn),
+	}, nil
+}
+
+func (c *UserClient) DeleteUserProfile(ctx context.Context, userID string) error {
+	_, err := c.users.DeleteUserData(ctx, &proto.DeleteUserDataRequest{UserId: userID})
+	return err
+}
+
+func (c *UserClient) ExportUserProfile(ctx context.Context, userID string) (*service.ExportedProfile, error) {
+	resp, err := c.users.ExportUserData(ctx, &proto.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetFound() {
+		return nil, nil
+	}
+
+	return &service.ExportedProfile{
+		FirstName:  resp.GetFirstName(),
+		LastName:   resp.GetLastName(),
+		Phone:      resp.GetPhone(),
+		Address:    resp.GetAddress(),
+		City:       resp.GetCity(),
+		Country:    resp.GetCountry(),
+		PostalCode: resp.GetPostalCode(),
+		CreatedAt:  resp.GetCreatedAt(),
+		UpdatedAt:  resp.GetUpdatedAt(),
+	}, nil
+}
+
+func (c *UserClient) SyncUserEmail(ctx context.Context, userID, email string) error {
+	_, err := c.users.SyncUserEmail(ctx, &proto.SyncUserEmailRequest{UserId: userID, Email: email})
+	return err
+}
+
+func (c *UserClient) Close() error {
+	return c.conn.Close()
+}
@@ -2,8 +2,10 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -17,6 +19,19 @@ type Config struct {
 	Security    SecurityConfig
 	Cookie      CookieConfig
 	Telemetry   TelemetryConfig
+	Mail        MailConfig
+	MFA         MFAConfig
+	Scheduler   SchedulerConfig
+	Revocation  RevocationConfig
+	OAuth       OAuthConfig
+	APIKey      APIKeyConfig
+	Password    PasswordConfig
+	Throttle    ThrottleConfig
+	Device      DeviceConfig
+	Services    ServicesConfig
+	Deletion    AccountDeletionConfig
+	Export      DataExportConfig
+	EmailChange EmailChangeConfig
 }
 
 type TelemetryConfig struct {
@@ -29,6 +44,10 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration
 	WriteTimeout    time.Duration
 	ShutdownTimeout time.Duration
+	// TrustedProxies are the addresses and CIDR ranges, such as Kong's,
+	// whose X-Forwarded-For header gives the client IP. Other callers are
+	// identified by their own address.
+	TrustedProxies []string
 }
 
 type DatabaseConfig struct {
@@ -43,17 +62,198 @@ type DatabaseConfig struct {
 }
 
 type JWTConfig struct {
-	Algorithm       string
-	PrivateKeyPath  string
-	PublicKeyPath   string
-	AccessTokenTTL  time.Duration
-	RefreshTokenTTL time.Duration
+	Algorithm           string
+	PrivateKeyPath      string
+	PublicKeyPath       string
+	AccessTokenTTL      time.Duration
+	RefreshTokenTTL     time.Duration
+	KeyRotationInterval time.Duration
+	KeyReloadInterval   time.Duration
 }
 
 type SecurityConfig struct {
-	MaxLoginAttempts    int
-	AccountLockDuration time.Duration
-	AllowedOrigins      []string
+	MaxLoginAttempts         int
+	AccountLockDuration      time.Duration
+	AllowedOrigins           []string
+	RequireEmailVerification bool
+	VerificationTokenTTL     time.Duration
+	PasswordResetTokenTTL    time.Duration
+	RefreshReuseGracePeriod  time.Duration
+	EncryptionKey            string
+}
+
+type MFAConfig struct {
+	Issuer            string
+	ChallengeTTL      time.Duration
+	RequiredForAdmins bool
+}
+
+// SchedulerConfig sets how often each cleanup job runs (0 disables it) and
+// how long rows are kept before the job deletes them.
+type SchedulerConfig struct {
+	RefreshTokenInterval       time.Duration
+	RefreshTokenRetention      time.Duration
+	TokenBlacklistInterval     time.Duration
+	TokenBlacklistRetention    time.Duration
+	VerificationTokenInterval  time.Duration
+	VerificationTokenRetention time.Duration
+	AuditLogInterval           time.Duration
+	AuditLogRetentionDays      int
+	AuthorizationCodeInterval  time.Duration
+	ThrottleEventInterval      time.Duration
+	KnownDeviceInterval        time.Duration
+	KnownDeviceRetention       time.Duration
+	AccountDeletionInterval    time.Duration
+	DataExportInterval         time.Duration
+	DataExportPurgeInterval    time.Duration
+	EmailSyncInterval          time.Duration
+	EmailChangeInterval        time.Duration
+}
+
+// RevocationConfig controls the in-memory cache of revoked access tokens
+// that every protected call is checked against.
+type RevocationConfig struct {
+	CacheSize    int
+	SyncInterval time.Duration
+	SyncOverlap  time.Duration
+}
+
+// OAuthConfig configures the OAuth 2.0 authorization server. Issuer is the
+// public base URL of the gateway and is the iss of ID tokens; AuthorizeURL is
+// the page of the login app that users are sent to by clients.
+// ServiceTokenTTL is the lifetime of client_credentials tokens.
+type OAuthConfig struct {
+	Issuer               string
+	AuthorizeURL         string
+	AuthorizationCodeTTL time.Duration
+	ServiceTokenTTL      time.Duration
+	MachineClients       []MachineClientSeed
+}
+
+// MachineClientSeed is a machine client registered at startup with
+// credentials chosen by the operator, so the services that use it can be
+// deployed without waiting for an admin to create it. It is written in
+// OAUTH_MACHINE_CLIENTS as "name:client_id:secret:scopes", with the scopes
+// space-separated and several clients separated by commas.
+type MachineClientSeed struct {
+	Name     string
+	ClientID string
+	Secret   string
+	Scopes   []string
+}
+
+// APIKeyConfig bounds the lifetime of personal API keys. Keys created without
+// an expiry get DefaultTTL.
+type APIKeyConfig struct {
+	DefaultTTL time.Duration
+	MaxTTL     time.Duration
+}
+
+// PasswordConfig sets the argon2id cost of new password hashes, in KiB of
+// memory, and the policy new passwords must meet. Hashes made with other
+// parameters, or with bcrypt, are upgraded at the user's next login.
+// With BreachedCheck, passwords are screened against a built-in list of
+// common passwords and, if BreachedListPath is set, a file of breached
+// passwords, one per line. HistorySize previous passwords cannot be reused, and
+// MaxAge, if set, makes users change older passwords at login.
+type PasswordConfig struct {
+	Argon2Memory      int
+	Argon2Iterations  int
+	Argon2Parallelism int
+
+	MinLength                 int
+	MaxLength                 int
+	CharacterClasses          []string
+	MaxRepeats                int
+	DisallowEmail             bool
+	BreachedCheck             bool
+	BreachedListPath          string
+	BreachedFalsePositiveRate float64
+
+	HistorySize    int
+	MaxAge         time.Duration
+	ChangeTokenTTL time.Duration
+}
+
+// ThrottleConfig sets the sliding-window limits on failed logins and on
+// registrations, per client IP, per email and globally. Past Free attempts
+// each one is delayed, from BaseDelay doubling up to MaxDelay; past Max the
+// key is blocked until the window moves on. Store is "postgres", shared by
+// all replicas, or "memory".
+type ThrottleConfig struct {
+	Store     string
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	LoginIPWindow     time.Duration
+	LoginIPFree       int
+	LoginIPMax        int
+	LoginEmailWindow  time.Duration
+	LoginEmailFree    int
+	LoginEmailMax     int
+	LoginGlobalWindow time.Duration
+	LoginGlobalMax    int
+
+	RegisterIPWindow     time.Duration
+	RegisterIPFree       int
+	RegisterIPMax        int
+	RegisterGlobalWindow time.Duration
+	RegisterGlobalMax    int
+}
+
+// DeviceConfig controls new-device login reports. UnrecognizedLoginLinkTTL
+// is how long the "was this you?" link works. With StepUp, a login from both
+// an unknown device and an unknown network of a user without MFA has to be
+// confirmed with a code sent by email, valid for StepUpTTL.
+type DeviceConfig struct {
+	UnrecognizedLoginLinkTTL time.Duration
+	StepUp                   bool
+	StepUpTTL                time.Duration
+}
+
+// ServicesConfig holds the addresses of the services the auth-service calls
+// to erase and export the data of its users.
+type ServicesConfig struct {
+	UserServiceAddr  string
+	OrderServiceAddr string
+}
+
+// AccountDeletionConfig controls the job that erases deleted accounts' data
+// in the other services: how many accounts one run handles, how long each
+// call may take, and the delay after a failed attempt, from RetryBaseDelay
+// doubling up to RetryMaxDelay.
+type AccountDeletionConfig struct {
+	BatchSize      int
+	CallTimeout    time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+// DataExportConfig controls personal data exports: how many exports one run
+// of the job builds, how long each call to another service may take, and how
+// long a finished archive can be downloaded.
+type DataExportConfig struct {
+	BatchSize   int
+	CallTimeout time.Duration
+	TTL         time.Duration
+}
+
+// EmailChangeConfig controls email address changes: how long the
+// confirmation link is valid, and the job that syncs confirmed changes to
+// the user-service, like AccountDeletionConfig.
+type EmailChangeConfig struct {
+	TokenTTL       time.Duration
+	BatchSize      int
+	CallTimeout    time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+type MailConfig struct {
+	Driver     string
+	From       string
+	OutboxDir  string
+	AppBaseURL string
 }
 
 type CookieConfig struct {
@@ -73,6 +273,7 @@ func Load() (*Config, error) {
 			ReadTimeout:     parseDuration(getEnv("SERVER_READ_TIMEOUT", "10s")),
 			WriteTimeout:    parseDuration(getEnv("SERVER_WRITE_TIMEOUT", "10s")),
 			ShutdownTimeout: parseDuration(getEnv("SERVER_SHUTDOWN_TIMEOUT", "5s")),
+			TrustedProxies:  parseList(getEnv("TRUSTED_PROXIES", "")),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -90,11 +291,19 @@ func Load() (*Config, error) {
 			PublicKeyPath:   getEnv("JWT_PUBLIC_KEY_PATH", "./certs/public_key.pem"),
 			AccessTokenTTL:  parseDuration(getEnv("ACCESS_TOKEN_TTL", "15m")),
 			RefreshTokenTTL: parseDuration(getEnv("REFRESH_TOKEN_TTL", "720h")),
+			// 0 disables scheduled rotation; keys can still be rotated by an admin.
+			KeyRotationInterval: parseDuration(getEnv("JWT_KEY_ROTATION_INTERVAL", "0")),
+			KeyReloadInterval:   parseDuration(getEnv("JWT_KEY_RELOAD_INTERVAL", "1m")),
 		},
 		Security: SecurityConfig{
-			MaxLoginAttempts:    parseInt(getEnv("MAX_LOGIN_ATTEMPTS", "5")),
-			AccountLockDuration: parseDuration(getEnv("ACCOUNT_LOCK_DURATION", "15m")),
-			AllowedOrigins:      parseStringSlice(getEnv("ALLOWED_ORIGINS", "http://localhost:3000")),
+			MaxLoginAttempts:         parseInt(getEnv("MAX_LOGIN_ATTEMPTS", "5")),
+			AccountLockDuration:      parseDuration(getEnv("ACCOUNT_LOCK_DURATION", "15m")),
+			AllowedOrigins:           parseStringSlice(getEnv("ALLOWED_ORIGINS", "http://localhost:3000")),
+			RequireEmailVerification: parseBool(getEnv("REQUIRE_EMAIL_VERIFICATION", "false")),
+			VerificationTokenTTL:     parseDuration(getEnv("VERIFICATION_TOKEN_TTL", "24h")),
+			PasswordResetTokenTTL:    parseDuration(getEnv("PASSWORD_RESET_TOKEN_TTL", "1h")),
+			RefreshReuseGracePeriod:  parseDuration(getEnv("REFRESH_TOKEN_REUSE_GRACE", "5s")),
+			EncryptionKey:            getEnv("ENCRYPTION_KEY", ""),
 		},
 		Cookie: CookieConfig{
 			RefreshTokenName: getEnv("COOKIE_REFRESH_TOKEN_NAME", "refresh_token"),
@@ -104,6 +313,119 @@ func Load() (*Config, error) {
 		Telemetry: TelemetryConfig{
 			CollectorAddr: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "jaeger:4317"),
 		},
+		Mail: MailConfig{
+			Driver:     getEnv("MAIL_DRIVER", "log"),
+			From:       getEnv("MAIL_FROM", "no-reply@localhost"),
+			OutboxDir:  getEnv("MAIL_OUTBOX_DIR", "./tmp/mail"),
+			AppBaseURL: getEnv("APP_BASE_URL", "http://localhost:3000"),
+		},
+		MFA: MFAConfig{
+			Issuer:            getEnv("MFA_ISSUER", "ecommerce"),
+			ChallengeTTL:      parseDuration(getEnv("MFA_CHALLENGE_TTL", "5m")),
+			RequiredForAdmins: parseBool(getEnv("MFA_REQUIRED_FOR_ADMINS", "true")),
+		},
+		Revocation: RevocationConfig{
+			CacheSize:    parseInt(getEnv("REVOCATION_CACHE_SIZE", "100000")),
+			SyncInterval: parseDuration(getEnv("REVOCATION_SYNC_INTERVAL", "5s")),
+			SyncOverlap:  parseDuration(getEnv("REVOCATION_SYNC_OVERLAP", "30s")),
+		},
+		Scheduler: SchedulerConfig{
+			RefreshTokenInterval:       parseDuration(getEnv("SCHEDULER_REFRESH_TOKEN_INTERVAL", "1h")),
+			RefreshTokenRetention:      parseDuration(getEnv("REFRESH_TOKEN_RETENTION", "24h")),
+			TokenBlacklistInterval:     parseDuration(getEnv("SCHEDULER_TOKEN_BLACKLIST_INTERVAL", "15m")),
+			TokenBlacklistRetention:    parseDuration(getEnv("TOKEN_BLACKLIST_RETENTION", "0")),
+			VerificationTokenInterval:  parseDuration(getEnv("SCHEDULER_VERIFICATION_TOKEN_INTERVAL", "1h")),
+			VerificationTokenRetention: parseDuration(getEnv("VERIFICATION_TOKEN_RETENTION", "24h")),
+			AuditLogInterval:           parseDuration(getEnv("SCHEDULER_AUDIT_LOG_INTERVAL", "24h")),
+			AuditLogRetentionDays:      parseInt(getEnv("AUDIT_LOG_RETENTION_DAYS", "90")),
+			AuthorizationCodeInterval:  parseDuration(getEnv("SCHEDULER_AUTHORIZATION_CODE_INTERVAL", "1h")),
+			ThrottleEventInterval:      parseDuration(getEnv("SCHEDULER_THROTTLE_EVENT_INTERVAL", "1h")),
+			KnownDeviceInterval:        parseDuration(getEnv("SCHEDULER_KNOWN_DEVICE_INTERVAL", "24h")),
+			KnownDeviceRetention:       parseDuration(getEnv("KNOWN_DEVICE_RETENTION", "4320h")),
+			AccountDeletionInterval:    parseDuration(getEnv("SCHEDULER_ACCOUNT_DELETION_INTERVAL", "1m")),
+			DataExportInterval:         parseDuration(getEnv("SCHEDULER_DATA_EXPORT_INTERVAL", "30s")),
+			DataExportPurgeInterval:    parseDuration(getEnv("SCHEDULER_DATA_EXPORT_PURGE_INTERVAL", "1h")),
+			EmailSyncInterval:          parseDuration(getEnv("SCHEDULER_EMAIL_SYNC_INTERVAL", "1m")),
+			EmailChangeInterval:        parseDuration(getEnv("SCHEDULER_EMAIL_CHANGE_INTERVAL", "1h")),
+		},
+		OAuth: OAuthConfig{
+			Issuer:               getEnv("OIDC_ISSUER", "http://localhost:8000"),
+			AuthorizeURL:         getEnv("OAUTH_AUTHORIZE_URL", "http://localhost:3000/oauth/authorize"),
+			AuthorizationCodeTTL: parseDuration(getEnv("OAUTH_AUTH_CODE_TTL", "1m")),
+			ServiceTokenTTL:      parseDuration(getEnv("OAUTH_SERVICE_TOKEN_TTL", "5m")),
+			MachineClients:       parseMachineClients(getEnv("OAUTH_MACHINE_CLIENTS", "")),
+		},
+		APIKey: APIKeyConfig{
+			DefaultTTL: parseDuration(getEnv("API_KEY_DEFAULT_TTL", "2160h")),
+			MaxTTL:     parseDuration(getEnv("API_KEY_MAX_TTL", "8760h")),
+		},
+		Password: PasswordConfig{
+			Argon2Memory:      parseInt(getEnv("PASSWORD_ARGON2_MEMORY", "19456")),
+			Argon2Iterations:  parseInt(getEnv("PASSWORD_ARGON2_ITERATIONS", "2")),
+			Argon2Parallelism: parseInt(getEnv("PASSWORD_ARGON2_PARALLELISM", "1")),
+
+			MinLength:                 parseInt(getEnv("PASSWORD_MIN_LENGTH", "8")),
+			MaxLength:                 parseInt(getEnv("PASSWORD_MAX_LENGTH", "128")),
+			CharacterClasses:          parseList(getEnv("PASSWORD_CHARACTER_CLASSES", "upper,lower,digit,special")),
+			MaxRepeats:                parseInt(getEnv("PASSWORD_MAX_REPEATS", "3")),
+			DisallowEmail:             parseBool(getEnv("PASSWORD_DISALLOW_EMAIL", "true")),
+			BreachedCheck:             parseBool(getEnv("PASSWORD_BREACHED_CHECK", "true")),
+			BreachedListPath:          getEnv("PASSWORD_BREACHED_LIST_PATH", ""),
+			BreachedFalsePositiveRate: parseFloat(getEnv("PASSWORD_BREACHED_FALSE_POSITIVE_RATE", "0.001")),
+
+			HistorySize: parseInt(getEnv("PASSWORD_HISTORY_SIZE", "5")),
+			// 0 disables password expiry.
+			MaxAge:         parseDuration(getEnv("PASSWORD_MAX_AGE", "0")),
+			ChangeTokenTTL: parseDuration(getEnv("PASSWORD_CHANGE_TOKEN_TTL", "10m")),
+		},
+		Throttle: ThrottleConfig{
+			Store:     getEnv("THROTTLE_STORE", "postgres"),
+			BaseDelay: parseDuration(getEnv("THROTTLE_BASE_DELAY", "1s")),
+			MaxDelay:  parseDuration(getEnv("THROTTLE_MAX_DELAY", "1m")),
+
+			// A 0 max disables the limit.
+			LoginIPWindow:     parseDuration(getEnv("THROTTLE_LOGIN_IP_WINDOW", "15m")),
+			LoginIPFree:       parseInt(getEnv("THROTTLE_LOGIN_IP_FREE", "5")),
+			LoginIPMax:        parseInt(getEnv("THROTTLE_LOGIN_IP_MAX", "50")),
+			LoginEmailWindow:  parseDuration(getEnv("THROTTLE_LOGIN_EMAIL_WINDOW", "15m")),
+			LoginEmailFree:    parseInt(getEnv("THROTTLE_LOGIN_EMAIL_FREE", "3")),
+			LoginEmailMax:     parseInt(getEnv("THROTTLE_LOGIN_EMAIL_MAX", "20")),
+			LoginGlobalWindow: parseDuration(getEnv("THROTTLE_LOGIN_GLOBAL_WINDOW", "1m")),
+			LoginGlobalMax:    parseInt(getEnv("THROTTLE_LOGIN_GLOBAL_MAX", "5000")),
+
+			RegisterIPWindow:     parseDuration(getEnv("THROTTLE_REGISTER_IP_WINDOW", "1h")),
+			RegisterIPFree:       parseInt(getEnv("THROTTLE_REGISTER_IP_FREE", "5")),
+			RegisterIPMax:        parseInt(getEnv("THROTTLE_REGISTER_IP_MAX", "20")),
+			RegisterGlobalWindow: parseDuration(getEnv("THROTTLE_REGISTER_GLOBAL_WINDOW", "1m")),
+			RegisterGlobalMax:    parseInt(getEnv("THROTTLE_REGISTER_GLOBAL_MAX", "1000")),
+		},
+		Device: DeviceConfig{
+			UnrecognizedLoginLinkTTL: parseDuration(getEnv("UNRECOGNIZED_LOGIN_LINK_TTL", "168h")),
+			StepUp:                   parseBool(getEnv("LOGIN_STEP_UP", "false")),
+			StepUpTTL:                parseDuration(getEnv("LOGIN_STEP_UP_TTL", "10m")),
+		},
+		Services: ServicesConfig{
+			UserServiceAddr:  getEnv("USER_SERVICE_ADDR", "user-service:9003"),
+			OrderServiceAddr: getEnv("ORDER_SERVICE_ADDR", "order-service:9004"),
+		},
+		Deletion: AccountDeletionConfig{
+			BatchSize:      parseInt(getEnv("ACCOUNT_DELETION_BATCH_SIZE", "50")),
+			CallTimeout:    parseDuration(getEnv("ACCOUNT_DELETION_CALL_TIMEOUT", "10s")),
+			RetryBaseDelay: parseDuration(getEnv("ACCOUNT_DELETION_RETRY_BASE_DELAY", "1m")),
+			RetryMaxDelay:  parseDuration(getEnv("ACCOUNT_DELETION_RETRY_MAX_DELAY", "6h")),
+		},
+		Export: DataExportConfig{
+			BatchSize:   parseInt(getEnv("DATA_EXPORT_BATCH_SIZE", "10")),
+			CallTimeout: parseDuration(getEnv("DATA_EXPORT_CALL_TIMEOUT", "30s")),
+			TTL:         parseDuration(getEnv("DATA_EXPORT_TTL", "72h")),
+		},
+		EmailChange: EmailChangeConfig{
+			TokenTTL:       parseDuration(getEnv("EMAIL_CHANGE_TOKEN_TTL", "1h")),
+			BatchSize:      parseInt(getEnv("EMAIL_SYNC_BATCH_SIZE", "50")),
+			CallTimeout:    parseDuration(getEnv("EMAIL_SYNC_CALL_TIMEOUT", "10s")),
+			RetryBaseDelay: parseDuration(getEnv("EMAIL_SYNC_RETRY_BASE_DELAY", "1m")),
+			RetryMaxDelay:  parseDuration(getEnv("EMAIL_SYNC_RETRY_MAX_DELAY", "6h")),
+		},
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -123,6 +445,165 @@ func (c *Config) Validate() error {
 	if c.Database.Password == "" {
 		return fmt.Errorf("DB_PASSWORD is required")
 	}
+	switch c.JWT.Algorithm {
+	case "RS256", "ES256", "EdDSA":
+	default:
+		return fmt.Errorf("JWT_ALGORITHM must be one of: RS256, ES256, EdDSA")
+	}
+	if c.JWT.KeyReloadInterval <= 0 {
+		return fmt.Errorf("JWT_KEY_RELOAD_INTERVAL must be positive")
+	}
+	if c.Security.EncryptionKey == "" {
+		return fmt.Errorf("ENCRYPTION_KEY is required")
+	}
+	if c.Revocation.CacheSize <= 0 {
+		return fmt.Errorf("REVOCATION_CACHE_SIZE must be positive")
+	}
+	if c.Revocation.SyncInterval <= 0 {
+		return fmt.Errorf("REVOCATION_SYNC_INTERVAL must be positive")
+	}
+	if c.Scheduler.RefreshTokenRetention < 0 || c.Scheduler.TokenBlacklistRetention < 0 ||
+		c.Scheduler.VerificationTokenRetention < 0 {
+		return fmt.Errorf("token retention periods must not be negative")
+	}
+	if c.Scheduler.AuditLogInterval > 0 && c.Scheduler.AuditLogRetentionDays <= 0 {
+		return fmt.Errorf("AUDIT_LOG_RETENTION_DAYS must be positive")
+	}
+	if c.OAuth.Issuer == "" || c.OAuth.AuthorizeURL == "" {
+		return fmt.Errorf("OIDC_ISSUER and OAUTH_AUTHORIZE_URL are required")
+	}
+	if c.OAuth.AuthorizationCodeTTL <= 0 {
+		return fmt.Errorf("OAUTH_AUTH_CODE_TTL must be positive")
+	}
+	// Retired signing keys stay published for one access-token TTL, so a
+	// service token must not outlive an access token.
+	if c.OAuth.ServiceTokenTTL <= 0 || c.OAuth.ServiceTokenTTL > c.JWT.AccessTokenTTL {
+		return fmt.Errorf("OAUTH_SERVICE_TOKEN_TTL must be positive and at most ACCESS_TOKEN_TTL")
+	}
+	for _, seed := range c.OAuth.MachineClients {
+		if seed.Name == "" || seed.ClientID == "" || seed.Secret == "" {
+			return fmt.Errorf("OAUTH_MACHINE_CLIENTS entries must be name:client_id:secret:scopes")
+		}
+		if len(seed.Secret) < 32 {
+			return fmt.Errorf("OAUTH_MACHINE_CLIENTS secret of %s must be at least 32 characters", seed.Name)
+		}
+	}
+	if c.APIKey.DefaultTTL <= 0 || c.APIKey.DefaultTTL > c.APIKey.MaxTTL {
+		return fmt.Errorf("API_KEY_DEFAULT_TTL must be positive and at most API_KEY_MAX_TTL")
+	}
+	if c.Password.Argon2Iterations < 1 {
+		return fmt.Errorf("PASSWORD_ARGON2_ITERATIONS must be positive")
+	}
+	if c.Password.Argon2Parallelism < 1 || c.Password.Argon2Parallelism > 255 {
+		return fmt.Errorf("PASSWORD_ARGON2_PARALLELISM must be between 1 and 255")
+	}
+	// argon2 needs at least 8 KiB per lane.
+	if c.Password.Argon2Memory < 8*c.Password.Argon2Parallelism {
+		return fmt.Errorf("PASSWORD_ARGON2_MEMORY must be at least 8 KiB per lane")
+	}
+	if c.Password.MinLength < 1 || c.Password.MaxLength < c.Password.MinLength {
+		return fmt.Errorf("PASSWORD_MIN_LENGTH must be positive and at most PASSWORD_MAX_LENGTH")
+	}
+	for _, class := range c.Password.CharacterClasses {
+		switch class {
+		case "upper", "lower", "digit", "special":
+		default:
+			return fmt.Errorf("PASSWORD_CHARACTER_CLASSES may only contain: upper, lower, digit, special")
+		}
+	}
+	if c.Password.MaxRepeats < 0 {
+		return fmt.Errorf("PASSWORD_MAX_REPEATS must not be negative")
+	}
+	if c.Password.BreachedListPath != "" && !c.Password.BreachedCheck {
+		return fmt.Errorf("PASSWORD_BREACHED_LIST_PATH requires PASSWORD_BREACHED_CHECK")
+	}
+	if c.Password.BreachedCheck &&
+		(c.Password.BreachedFalsePositiveRate <= 0 || c.Password.BreachedFalsePositiveRate >= 1) {
+		return fmt.Errorf("PASSWORD_BREACHED_FALSE_POSITIVE_RATE must be between 0 and 1")
+	}
+	if c.Password.HistorySize < 0 {
+		return fmt.Errorf("PASSWORD_HISTORY_SIZE must not be negative")
+	}
+	if c.Password.MaxAge < 0 {
+		return fmt.Errorf("PASSWORD_MAX_AGE must not be negative")
+	}
+	if c.Password.MaxAge > 0 && c.Password.ChangeTokenTTL <= 0 {
+		return fmt.Errorf("PASSWORD_CHANGE_TOKEN_TTL must be positive")
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("TRUSTED_PROXIES entries must be IP addresses or CIDR ranges, got %q", proxy)
+		}
+	}
+	if c.Throttle.Store != "postgres" && c.Throttle.Store != "memory" {
+		return fmt.Errorf("THROTTLE_STORE must be one of: postgres, memory")
+	}
+	if c.Throttle.BaseDelay < 0 || c.Throttle.MaxDelay < c.Throttle.BaseDelay {
+		return fmt.Errorf("THROTTLE_BASE_DELAY must not be negative and at most THROTTLE_MAX_DELAY")
+	}
+	for _, limit := range []struct {
+		name      string
+		window    time.Duration
+		free, max int
+	}{
+		{"THROTTLE_LOGIN_IP", c.Throttle.LoginIPWindow, c.Throttle.LoginIPFree, c.Throttle.LoginIPMax},
+		{"THROTTLE_LOGIN_EMAIL", c.Throttle.LoginEmailWindow, c.Throttle.LoginEmailFree, c.Throttle.LoginEmailMax},
+		{"THROTTLE_LOGIN_GLOBAL", c.Throttle.LoginGlobalWindow, 0, c.Throttle.LoginGlobalMax},
+		{"THROTTLE_REGISTER_IP", c.Throttle.RegisterIPWindow, c.Throttle.RegisterIPFree, c.Throttle.RegisterIPMax},
+		{"THROTTLE_REGISTER_GLOBAL", c.Throttle.RegisterGlobalWindow, 0, c.Throttle.RegisterGlobalMax},
+	} {
+		if limit.max < 0 || limit.free < 0 {
+			return fmt.Errorf("%s_FREE and %s_MAX must not be negative", limit.name, limit.name)
+		}
+		if limit.max > 0 && limit.window <= 0 {
+			return fmt.Errorf("%s_WINDOW must be positive", limit.name)
+		}
+	}
+	if c.Scheduler.KnownDeviceInterval > 0 && c.Scheduler.KnownDeviceRetention <= 0 {
+		return fmt.Errorf("KNOWN_DEVICE_RETENTION must be positive")
+	}
+	if c.Device.UnrecognizedLoginLinkTTL <= 0 {
+		return fmt.Errorf("UNRECOGNIZED_LOGIN_LINK_TTL must be positive")
+	}
+	if c.Device.StepUp && c.Device.StepUpTTL <= 0 {
+		return fmt.Errorf("LOGIN_STEP_UP_TTL must be positive")
+	}
+	if c.Services.UserServiceAddr == "" || c.Services.OrderServiceAddr == "" {
+		return fmt.Errorf("USER_SERVICE_ADDR and ORDER_SERVICE_ADDR are required")
+	}
+	if c.Deletion.BatchSize <= 0 {
+		return fmt.Errorf("ACCOUNT_DELETION_BATCH_SIZE must be positive")
+	}
+	if c.Deletion.CallTimeout <= 0 {
+		return fmt.Errorf("ACCOUNT_DELETION_CALL_TIMEOUT must be positive")
+	}
+	if c.Deletion.RetryBaseDelay <= 0 || c.Deletion.RetryMaxDelay < c.Deletion.RetryBaseDelay {
+		return fmt.Errorf("ACCOUNT_DELETION_RETRY_BASE_DELAY must be positive and at most ACCOUNT_DELETION_RETRY_MAX_DELAY")
+	}
+	if c.Export.BatchSize <= 0 {
+		return fmt.Errorf("DATA_EXPORT_BATCH_SIZE must be positive")
+	}
+	if c.Export.CallTimeout <= 0 {
+		return fmt.Errorf("DATA_EXPORT_CALL_TIMEOUT must be positive")
+	}
+	if c.Export.TTL <= 0 {
+		return fmt.Errorf("DATA_EXPORT_TTL must be positive")
+	}
+	if c.EmailChange.TokenTTL <= 0 {
+		return fmt.Errorf("EMAIL_CHANGE_TOKEN_TTL must be positive")
+	}
+	if c.EmailChange.BatchSize <= 0 {
+		return fmt.Errorf("EMAIL_SYNC_BATCH_SIZE must be positive")
+	}
+	if c.EmailChange.CallTimeout <= 0 {
+		return fmt.Errorf("EMAIL_SYNC_CALL_TIMEOUT must be positive")
+	}
+	if c.EmailChange.RetryBaseDelay <= 0 || c.EmailChange.RetryMaxDelay < c.EmailChange.RetryBaseDelay {
+		return fmt.Errorf("EMAIL_SYNC_RETRY_BASE_DELAY must be positive and at most EMAIL_SYNC_RETRY_MAX_DELAY")
+	}
+	if c.Mail.Driver != "log" && c.Mail.Driver != "file" {
+		return fmt.Errorf("MAIL_DRIVER must be one of: log, file")
+	}
 	return nil
 }
 
@@ -157,6 +638,41 @@ func parseStringSlice(s string) []string {
 	return []string{s}
 }
 
+// parseList splits a comma-separated list, dropping empty items.
+func parseList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseMachineClients reads OAUTH_MACHINE_CLIENTS. Malformed entries are
+// kept with their fields missing so that Validate reports them.
+func parseMachineClients(s string) []MachineClientSeed {
+	var seeds []MachineClientSeed
+	for _, item := range parseList(s) {
+		parts := strings.SplitN(item, ":", 4)
+		for len(parts) < 4 {
+			parts = append(parts, "")
+		}
+		seeds = append(seeds, MachineClientSeed{
+			Name:     strings.TrimSpace(parts[0]),
+			ClientID: strings.TrimSpace(parts[1]),
+			Secret:   strings.TrimSpace(parts[2]),
+			Scopes:   strings.Fields(parts[3]),
+		})
+	}
+	return seeds
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
 func parseBool(s string) bool {
 	b, _ := strconv.ParseBool(s)
 	return b
@@ -0,0 +1,28 @@
+package mail
+
+import (
+	"context"
+
+	"auth-service/internal/domain/service"
+
+	"go.uber.org/zap"
+)
+
+// LogSender writes outgoing mail to the application log instead of
+// delivering it. Intended for local development only.
+type LogSender struct {
+	log *zap.Logger
+}
+
+func NewLogSender(log *zap.Logger) *LogSender {
+	return &LogSender{log: log}
+}
+
+func (s *LogSender) Send(ctx context.Context, msg service.MailMessage) error {
+	s.log.Info("outgoing mail",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("body", msg.Body),
+	)
+	return nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AccountDeletionModel struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID             uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	ProfileDeletedAt   *time.Time
+	OrdersAnonymizedAt *time.Time
+	Attempts           int `gorm:"not null;default:0"`
+	LastError          string
+	NextAttemptAt      time.Time `gorm:"not null;index"`
+	CompletedAt        *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (AccountDeletionModel) TableName() string {
+	return "account_deletions"
+}
+
+type AccountDeletionRepository struct {
+	db          *gorm.DB
+	users       *UserRepository
+	revocations *TokenBlacklistRepository
+}
+
+func NewAccountDeletionRepository(db *gorm.DB) *AccountDeletionRepository {
+	return &AccountDeletionRepository{db: db, users: NewUserRepository(db), revocations: NewTokenBlacklistRepository(db)}
+}
+
+func (r *AccountDeletionRepository) Start(ctx context.Context, user *entity.User, deletion *entity.AccountDeletion, revocation *entity.TokenBlacklist) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Select("*").Save(r.users.toModel(user))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Delete(&UserModel{}, "id = ?", user.ID).Error; err != nil {
+			return err
+		}
+		for _, model := range []interface{}{
+			&RefreshTokenModel{},
+			&VerificationTokenModel{},
+			&RecoveryCodeModel{},
+			&APIKeyModel{},
+			&PasswordHistoryModel{},
+			&KnownDeviceModel{},
+			&OAuthConsentModel{},
+			&OAuthAuthorizationCodeModel{},
+			&DataExportModel{},
+			&EmailChangeModel{},
+		} {
+			if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(r.revocations.toModel(revocation)).Error; err != nil {
+			return err
+		}
+		return tx.Create(r.toModel(deletion)).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainErr.ErrUserNotFound
+		}
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *AccountDeletionRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.AccountDeletion, error) {
+	var models []AccountDeletionModel
+	if err := r.db.WithContext(ctx).
+		Where("completed_at IS NULL AND next_attempt_at <= ?", now).
+		Order("created_at").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	deletions := make([]*entity.AccountDeletion, len(models))
+	for i, model := range models {
+		deletions[i] = r.toEntity(&model)
+	}
+	return deletions, nil
+}
+
+func (r *AccountDeletionRepository) Update(ctx context.Context, deletion *entity.AccountDeletion) error {
+	if err := r.db.WithContext(ctx).Save(r.toModel(deletion)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *AccountDeletionRepository) toModel(deletion *entity.AccountDeletion) *AccountDeletionModel {
+	return &AccountDeletionModel{
+		ID:                 deletion.ID,
+		UserID:             deletion.UserID,
+		ProfileDeletedAt:   deletion.ProfileDeletedAt,
+		OrdersAnonymizedAt: deletion.OrdersAnonymizedAt,
+		Attempts:           deletion.Attempts,
+		LastError:          deletion.LastError,
+		NextAttemptAt:      deletion.NextAttemptAt,
+		CompletedAt:        deletion.CompletedAt,
+		CreatedAt:          deletion.CreatedAt,
+		UpdatedAt:          deletion.UpdatedAt,
+	}
+}
+
+func (r *AccountDeletionRepository) toEntity(model *AccountDeletionModel) *entity.AccountDeletion {
+	return &entity.AccountDeletion{
+		ID:                 model.ID,
+		UserID:             model.UserID,
+		ProfileDeletedAt:   model.ProfileDeletedAt,
+		OrdersAnonymizedAt: model.OrdersAnonymizedAt,
+		Attempts:           model.Attempts,
+		LastError:          model.LastError,
+		NextAttemptAt:      model.NextAttemptAt,
+		CompletedAt:        model.CompletedAt,
+		CreatedAt:          model.CreatedAt,
+		UpdatedAt:          model.UpdatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"auth-service/internal/domain/service"
+
+	"gorm.io/gorm"
+)
+
+const advisoryUnlockTimeout = 5 * time.Second
+
+// AdvisoryLock implements service.LeaderLock with session-level Postgres
+// advisory locks. Each lease pins one pooled connection; if that connection
+// drops, Postgres releases the lock and another replica can take over.
+type AdvisoryLock struct {
+	db *gorm.DB
+}
+
+func NewAdvisoryLock(db *gorm.DB) *AdvisoryLock {
+	return &AdvisoryLock{db: db}
+}
+
+func (l *AdvisoryLock) TryAcquire(ctx context.Context, name string) (service.LeaderLease, bool, error) {
+	sqlDB, err := l.db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	return &advisoryLease{conn: conn, name: name}, true, nil
+}
+
+type advisoryLease struct {
+	conn *sql.Conn
+	name string
+}
+
+func (l *advisoryLease) Check(ctx context.Context) error {
+	return l.conn.PingContext(ctx)
+}
+
+func (l *advisoryLease) Release() {
+	ctx, cancel := context.WithTimeout(context.Background(), advisoryUnlockTimeout)
+	defer cancel()
+
+	// Closing a *sql.Conn returns it to the pool with the lock still held, so
+	// unlock explicitly. If that fails, discard the connection instead; ending
+	// the session releases the lock.
+	if _, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", l.name); err != nil {
+		_ = l.conn.Raw(func(any) error { return driver.ErrBadConn })
+	}
+	l.conn.Close()
+}
@@ -0,0 +1,101 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/service"
+	"auth-service/internal/infrastructure/scheduler"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func TestAdvisoryLockHasOneHolder(t *testing.T) {
+	ctx := context.Background()
+	first, second := NewAdvisoryLock(newTestDB(t)), NewAdvisoryLock(newTestDB(t))
+	name := "test:" + uuid.NewString()
+
+	lease, ok, err := first.TryAcquire(ctx, name)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, ok, err = second.TryAcquire(ctx, name)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	lease.Release()
+	lease, ok, err = second.TryAcquire(ctx, name)
+	require.NoError(t, err)
+	require.True(t, ok)
+	lease.Release()
+}
+
+// renamedLock takes its lock under name, so that the test does not compete
+// with a server running on the same database.
+type renamedLock struct {
+	*AdvisoryLock
+	name string
+}
+
+func (l renamedLock) TryAcquire(ctx context.Context, _ string) (service.LeaderLease, bool, error) {
+	return l.AdvisoryLock.TryAcquire(ctx, l.name)
+}
+
+// TestSchedulerLeaderElection runs one job on two replicas, each with its
+// own connection pool, and ends the leader's database session the way a
+// crashed replica would lose it.
+func TestSchedulerLeaderElection(t *testing.T) {
+	leaderDB, followerDB := newTestDB(t), newTestDB(t)
+	lockName := "test:" + uuid.NewString()
+
+	var leaderRuns, followerRuns atomic.Int64
+	start := func(db *gorm.DB, interval time.Duration, runs *atomic.Int64) {
+		s := scheduler.New(renamedLock{NewAdvisoryLock(db), lockName}, zap.NewNop())
+		s.Register(scheduler.Job{
+			Name:     "test",
+			Interval: interval,
+			Run: func(context.Context) (int64, error) {
+				runs.Add(1)
+				return 0, nil
+			},
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			s.Run(ctx)
+		}()
+		t.Cleanup(func() {
+			cancel()
+			<-done
+		})
+	}
+
+	// The leader ticks too rarely to notice the lost lock and take it back
+	// before the follower does.
+	start(leaderDB, time.Hour, &leaderRuns)
+	require.Eventually(t, func() bool { return leaderRuns.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	start(followerDB, 50*time.Millisecond, &followerRuns)
+	time.Sleep(500 * time.Millisecond)
+	require.Zero(t, followerRuns.Load())
+
+	var terminated bool
+	require.NoError(t, followerDB.Raw(
+		`SELECT pg_terminate_backend(pid) FROM pg_locks
+		 WHERE locktype = 'advisory' AND granted AND objsubid = 1
+		   AND objid = (hashtext(?)::bigint & 4294967295)::oid`,
+		lockName,
+	).Scan(&terminated).Error)
+	require.True(t, terminated)
+
+	require.Eventually(t, func() bool { return followerRuns.Load() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(1), leaderRuns.Load())
+}
@@ -8,6 +8,7 @@ import (
 
 	"auth-service/internal/domain/entity"
 	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -75,14 +76,56 @@ func (r *AuditLogRepository) FindByUserID(ctx context.Context, userID uuid.UUID,
 	return logs, nil
 }
 
-func (r *AuditLogRepository) DeleteOlderThan(ctx context.Context, days int) error {
+func (r *AuditLogRepository) Search(ctx context.Context, filter repository.AuditLogFilter, after *repository.AuditLogCursor, limit int) ([]*entity.AuditLog, error) {
+	query := r.db.WithContext(ctx).Model(&AuditLogModel{})
+
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if len(filter.Actions) > 0 {
+		actions := make([]string, len(filter.Actions))
+		for i, action := range filter.Actions {
+			actions[i] = string(action)
+		}
+		query = query.Where("action IN ?", actions)
+	}
+	if filter.IPAddress != "" {
+		query = query.Where("ip_address = ?", filter.IPAddress)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var models []AuditLogModel
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	logs := make([]*entity.AuditLog, len(models))
+	for i, model := range models {
+		logs[i] = r.toEntity(&model)
+	}
+	return logs, nil
+}
+
+func (r *AuditLogRepository) DeleteOlderThan(ctx context.Context, days int) (int64, error) {
 	cutoffDate := time.Now().AddDate(0, 0, -days)
-	if err := r.db.WithContext(ctx).
+	result := r.db.WithContext(ctx).
 		Where("created_at < ?", cutoffDate).
-		Delete(&AuditLogModel{}).Error; err != nil {
-		return domainErr.ErrDatabase
+		Delete(&AuditLogModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
 	}
-	return nil
+	return result.RowsAffected, nil
 }
 
 func (r *AuditLogRepository) toModel(log *entity.AuditLog) *AuditLogModel {
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DataExportModel struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Status    string    `gorm:"not null;index"`
+	SizeBytes int64     `gorm:"not null;default:0"`
+	Error     string
+	// Archive is left out of every query except FindArchive.
+	Archive     []byte `gorm:"type:bytea"`
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time `gorm:"index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (DataExportModel) TableName() string {
+	return "data_exports"
+}
+
+type DataExportRepository struct {
+	db *gorm.DB
+}
+
+func NewDataExportRepository(db *gorm.DB) *DataExportRepository {
+	return &DataExportRepository{db: db}
+}
+
+func (r *DataExportRepository) Create(ctx context.Context, export *entity.DataExport) error {
+	if err := r.db.WithContext(ctx).Create(r.toModel(export)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *DataExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.DataExport, error) {
+	var model DataExportModel
+	if err := r.db.WithContext(ctx).Omit("archive").Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrDataExportNotFound
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *DataExportRepository) FindPendingByUserID(ctx context.Context, userID uuid.UUID) (*entity.DataExport, error) {
+	var models []DataExportModel
+	if err := r.db.WithContext(ctx).
+		Omit("archive").
+		Where("user_id = ? AND status = ?", userID, string(entity.DataExportStatusPending)).
+		Order("created_at DESC").
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	return r.toEntity(&models[0]), nil
+}
+
+func (r *DataExportRepository) ListPending(ctx context.Context, limit int) ([]*entity.DataExport, error) {
+	var models []DataExportModel
+	if err := r.db.WithContext(ctx).
+		Omit("archive").
+		Where("status = ?", string(entity.DataExportStatusPending)).
+		Order("created_at").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	exports := make([]*entity.DataExport, len(models))
+	for i, model := range models {
+		exports[i] = r.toEntity(&model)
+	}
+	return exports, nil
+}
+
+func (r *DataExportRepository) Update(ctx context.Context, export *entity.DataExport, archive []byte) error {
+	model := r.toModel(export)
+	// Select("*") keeps Save from inserting the export again if the account
+	// was deleted, and the export with it, while the archive was built.
+	query := r.db.WithContext(ctx).Select("*")
+	if archive != nil {
+		model.Archive = archive
+	} else {
+		query = query.Omit("archive")
+	}
+	if err := query.Save(model).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *DataExportRepository) FindArchive(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	var model DataExportModel
+	if err := r.db.WithContext(ctx).
+		Select("archive").
+		Where("id = ? AND status = ?", id, string(entity.DataExportStatusReady)).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrDataExportNotFound
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return model.Archive, nil
+}
+
+func (r *DataExportRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", now).Delete(&DataExportModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *DataExportRepository) toModel(export *entity.DataExport) *DataExportModel {
+	return &DataExportModel{
+		ID:          export.ID,
+		UserID:      export.UserID,
+		Status:      string(export.Status),
+		SizeBytes:   export.SizeBytes,
+		Error:       export.Error,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+		CreatedAt:   export.CreatedAt,
+		UpdatedAt:   export.UpdatedAt,
+	}
+}
+
+func (r *DataExportRepository) toEntity(model *DataExportModel) *entity.DataExport {
+	return &entity.DataExport{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Status:      entity.DataExportStatus(model.Status),
+		SizeBytes:   model.SizeBytes,
+		Error:       model.Error,
+		CompletedAt: model.CompletedAt,
+		ExpiresAt:   model.ExpiresAt,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}
+}
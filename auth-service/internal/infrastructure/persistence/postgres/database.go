@@ -37,6 +37,19 @@ func AutoMigrate(db *gorm.DB) error {
 		&RefreshTokenModel{},
 		&TokenBlacklistModel{},
 		&AuditLogModel{},
+		&VerificationTokenModel{},
+		&RecoveryCodeModel{},
+		&SigningKeyModel{},
+		&OAuthClientModel{},
+		&OAuthAuthorizationCodeModel{},
+		&OAuthConsentModel{},
+		&APIKeyModel{},
+		&PasswordHistoryModel{},
+		&ThrottleEventModel{},
+		&KnownDeviceModel{},
+		&AccountDeletionModel{},
+		&DataExportModel{},
+		&EmailChangeModel{},
 	)
 }
 
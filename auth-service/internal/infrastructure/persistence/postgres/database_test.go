@@ -0,0 +1,49 @@
+//go:build integration
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"auth-service/internal/infrastructure/config"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newTestDB connects to the database named by the service's own DB_*
+// variables and migrates it. Tests are skipped when it cannot be reached.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	getEnv := func(key, fallback string) string {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+		return fallback
+	}
+
+	db, err := NewDatabase(&config.DatabaseConfig{
+		Host:            getEnv("DB_HOST", "localhost"),
+		Port:            getEnv("DB_PORT", "5432"),
+		User:            getEnv("DB_USER", "postgres"),
+		Password:        getEnv("DB_PASSWORD", "postgres"),
+		DBName:          getEnv("DB_NAME", "auth_db"),
+		MaxOpenConns:    10,
+		MaxIdleConns:    10,
+		ConnMaxLifetime: time.Minute,
+	})
+	if err != nil {
+		t.Skipf("Skipping integration test: database not reachable: %v", err)
+	}
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	if err := sqlDB.Ping(); err != nil {
+		t.Skipf("Skipping integration test: database not reachable: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	require.NoError(t, AutoMigrate(db))
+	return db
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type EmailChangeModel struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	OldEmail      string    `gorm:"not null"`
+	NewEmail      string    `gorm:"not null"`
+	TokenHash     string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt     time.Time `gorm:"not null;index"`
+	ConfirmedAt   *time.Time
+	SyncedAt      *time.Time
+	SyncAttempts  int `gorm:"not null;default:0"`
+	LastSyncError string
+	NextSyncAt    *time.Time `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (EmailChangeModel) TableName() string {
+	return "email_changes"
+}
+
+type EmailChangeRepository struct {
+	db    *gorm.DB
+	users *UserRepository
+}
+
+func NewEmailChangeRepository(db *gorm.DB) *EmailChangeRepository {
+	return &EmailChangeRepository{db: db, users: NewUserRepository(db)}
+}
+
+func (r *EmailChangeRepository) Create(ctx context.Context, change *entity.EmailChange) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND confirmed_at IS NULL", change.UserID).Delete(&EmailChangeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(r.toModel(change)).Error
+	})
+	if err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *EmailChangeRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.EmailChange, error) {
+	var model EmailChangeModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidToken
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *EmailChangeRepository) Confirm(ctx context.Context, user *entity.User, change *entity.EmailChange) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&EmailChangeModel{}).
+			Where("id = ? AND confirmed_at IS NULL", change.ID).
+			Updates(map[string]interface{}{
+				"confirmed_at": change.ConfirmedAt,
+				"next_sync_at": change.NextSyncAt,
+				"updated_at":   change.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErr.ErrInvalidToken
+		}
+
+		// Deleted accounts keep their row, with an erased email, so they
+		// are counted as well.
+		var taken int64
+		if err := tx.Unscoped().Model(&UserModel{}).
+			Where("lower(email) = lower(?) AND id <> ?", user.Email, user.ID).
+			Count(&taken).Error; err != nil {
+			return err
+		}
+		if taken > 0 {
+			return domainErr.ErrUserAlreadyExists
+		}
+
+		// Only the email columns are written, so concurrent changes to the
+		// rest of the row, such as failed login counters, are kept. The
+		// change is stale if the email moved on since it was requested.
+		result = tx.Model(&UserModel{}).
+			Where("id = ? AND email = ?", user.ID, change.OldEmail).
+			Updates(map[string]interface{}{
+				"email":       user.Email,
+				"is_verified": user.IsVerified,
+				"updated_at":  user.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErr.ErrInvalidToken
+		}
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, domainErr.ErrInvalidToken) || errors.Is(err, domainErr.ErrUserAlreadyExists) {
+		return err
+	}
+	// A concurrent registration or change can claim the email after the
+	// check; the unique index on users.email then fails the save.
+	if exists, existsErr := r.users.ExistsByEmail(ctx, user.Email); existsErr == nil && exists {
+		return domainErr.ErrUserAlreadyExists
+	}
+	return domainErr.ErrDatabase
+}
+
+func (r *EmailChangeRepository) ListUnsynced(ctx context.Context, now time.Time, limit int) ([]*entity.EmailChange, error) {
+	var models []EmailChangeModel
+	if err := r.db.WithContext(ctx).
+		Where("confirmed_at IS NOT NULL AND synced_at IS NULL AND next_sync_at <= ?", now).
+		Order("confirmed_at").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	changes := make([]*entity.EmailChange, len(models))
+	for i, model := range models {
+		changes[i] = r.toEntity(&model)
+	}
+	return changes, nil
+}
+
+func (r *EmailChangeRepository) Update(ctx context.Context, change *entity.EmailChange) error {
+	// Selecting the columns stops Save from recreating a change that was
+	// deleted along with its account.
+	if err := r.db.WithContext(ctx).Select("*").Save(r.toModel(change)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *EmailChangeRepository) DeleteFinished(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("(confirmed_at IS NULL AND expires_at < ?) OR synced_at IS NOT NULL", now).
+		Delete(&EmailChangeModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *EmailChangeRepository) toModel(change *entity.EmailChange) *EmailChangeModel {
+	return &EmailChangeModel{
+		ID:            change.ID,
+		UserID:        change.UserID,
+		OldEmail:      change.OldEmail,
+		NewEmail:      change.NewEmail,
+		TokenHash:     change.TokenHash,
+		ExpiresAt:     change.ExpiresAt,
+		ConfirmedAt:   change.ConfirmedAt,
+		SyncedAt:      change.SyncedAt,
+		SyncAttempts:  change.SyncAttempts,
+		LastSyncError: change.LastSyncError,
+		NextSyncAt:    change.NextSyncAt,
+		CreatedAt:     change.CreatedAt,
+		UpdatedAt:     change.UpdatedAt,
+	}
+}
+
+func (r *EmailChangeRepository) toEntity(model *EmailChangeModel) *entity.EmailChange {
+	return &entity.EmailChange{
+		ID:            model.ID,
+		UserID:        model.UserID,
+		OldEmail:      model.OldEmail,
+		NewEmail:      model.NewEmail,
+		TokenHash:     model.TokenHash,
+		ExpiresAt:     model.ExpiresAt,
+		ConfirmedAt:   model.ConfirmedAt,
+		SyncedAt:      model.SyncedAt,
+		SyncAttempts:  model.SyncAttempts,
+		LastSyncError: model.LastSyncError,
+		NextSyncAt:    model.NextSyncAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestUser(t *testing.T, users *UserRepository) *entity.User {
+	t.Helper()
+	user := entity.NewUser("user_"+uuid.NewString()+"@example.com", "hash")
+	require.NoError(t, users.Create(context.Background(), user))
+	return user
+}
+
+func TestConfirmKeepsConcurrentUserUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users, changes := NewUserRepository(db), NewEmailChangeRepository(db)
+
+	user := createTestUser(t, users)
+	change := entity.NewEmailChange(user.ID, user.Email, "new_"+uuid.NewString()+"@example.com", uuid.NewString(), time.Now().Add(time.Hour))
+	require.NoError(t, changes.Create(ctx, change))
+
+	// A failed login lands between reading the user and confirming.
+	stale := *user
+	user.FailedLoginAttempts = 3
+	require.NoError(t, users.Update(ctx, user))
+
+	stale.ChangeEmail(change.NewEmail)
+	change.Confirm()
+	require.NoError(t, changes.Confirm(ctx, &stale, change))
+
+	stored, err := users.FindByID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, change.NewEmail, stored.Email)
+	require.True(t, stored.IsVerified)
+	require.Equal(t, 3, stored.FailedLoginAttempts)
+}
+
+func TestConfirmRejectsEmailTakenInOtherCase(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users, changes := NewUserRepository(db), NewEmailChangeRepository(db)
+
+	user, other := createTestUser(t, users), createTestUser(t, users)
+	change := entity.NewEmailChange(user.ID, user.Email, strings.ToUpper(other.Email), uuid.NewString(), time.Now().Add(time.Hour))
+	require.NoError(t, changes.Create(ctx, change))
+
+	user.ChangeEmail(change.NewEmail)
+	change.Confirm()
+	require.ErrorIs(t, changes.Confirm(ctx, user, change), domainErr.ErrUserAlreadyExists)
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type KnownDeviceModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_known_devices_user_device,priority:1"`
+	Fingerprint string    `gorm:"not null;uniqueIndex:idx_known_devices_user_device,priority:2"`
+	IPPrefix    string    `gorm:"column:ip_prefix;not null;uniqueIndex:idx_known_devices_user_device,priority:3"`
+	UserAgent   string
+	FirstSeenAt time.Time `gorm:"not null"`
+	LastSeenAt  time.Time `gorm:"not null;index"`
+}
+
+func (KnownDeviceModel) TableName() string {
+	return "known_devices"
+}
+
+type KnownDeviceRepository struct {
+	db *gorm.DB
+}
+
+func NewKnownDeviceRepository(db *gorm.DB) *KnownDeviceRepository {
+	return &KnownDeviceRepository{db: db}
+}
+
+func (r *KnownDeviceRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.KnownDevice, error) {
+	var models []KnownDeviceModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("last_seen_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	devices := make([]*entity.KnownDevice, len(models))
+	for i, model := range models {
+		devices[i] = &entity.KnownDevice{
+			ID:          model.ID,
+			UserID:      model.UserID,
+			Fingerprint: model.Fingerprint,
+			IPPrefix:    model.IPPrefix,
+			UserAgent:   model.UserAgent,
+			FirstSeenAt: model.FirstSeenAt,
+			LastSeenAt:  model.LastSeenAt,
+		}
+	}
+	return devices, nil
+}
+
+func (r *KnownDeviceRepository) Upsert(ctx context.Context, device *entity.KnownDevice) error {
+	model := &KnownDeviceModel{
+		ID:          device.ID,
+		UserID:      device.UserID,
+		Fingerprint: device.Fingerprint,
+		IPPrefix:    device.IPPrefix,
+		UserAgent:   device.UserAgent,
+		FirstSeenAt: device.FirstSeenAt,
+		LastSeenAt:  device.LastSeenAt,
+	}
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "fingerprint"}, {Name: "ip_prefix"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_agent", "last_seen_at"}),
+		}).
+		Create(model).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *KnownDeviceRepository) Delete(ctx context.Context, userID uuid.UUID, fingerprint, ipPrefix string) error {
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND fingerprint = ? AND ip_prefix = ?", userID, fingerprint, ipPrefix).
+		Delete(&KnownDeviceModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *KnownDeviceRepository) DeleteNotSeenSince(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("last_seen_at < ?", before).
+		Delete(&KnownDeviceModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
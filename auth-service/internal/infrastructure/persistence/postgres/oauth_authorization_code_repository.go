@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OAuthAuthorizationCodeModel struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CodeHash      string    `gorm:"uniqueIndex;not null"`
+	ClientID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	RedirectURI   string    `gorm:"not null"`
+	Scopes        string    `gorm:"not null"`
+	CodeChallenge string    `gorm:"not null"`
+	Nonce         string
+	ExpiresAt     time.Time `gorm:"not null;index"`
+	UsedAt        *time.Time
+	SessionID     *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt     time.Time
+}
+
+func (OAuthAuthorizationCodeModel) TableName() string {
+	return "oauth_authorization_codes"
+}
+
+type OAuthAuthorizationCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewOAuthAuthorizationCodeRepository(db *gorm.DB) *OAuthAuthorizationCodeRepository {
+	return &OAuthAuthorizationCodeRepository{db: db}
+}
+
+func (r *OAuthAuthorizationCodeRepository) Create(ctx context.Context, code *entity.OAuthAuthorizationCode) error {
+	if err := r.db.WithContext(ctx).Create(r.toModel(code)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *OAuthAuthorizationCodeRepository) FindByCodeHash(ctx context.Context, codeHash string) (*entity.OAuthAuthorizationCode, error) {
+	var model OAuthAuthorizationCodeModel
+	if err := r.db.WithContext(ctx).Where("code_hash = ?", codeHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidGrant
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *OAuthAuthorizationCodeRepository) MarkUsed(ctx context.Context, id, sessionID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&OAuthAuthorizationCodeModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"used_at":    time.Now(),
+			"session_id": sessionID,
+		})
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrInvalidGrant
+	}
+	return nil
+}
+
+func (r *OAuthAuthorizationCodeRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&OAuthAuthorizationCodeModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *OAuthAuthorizationCodeRepository) toModel(code *entity.OAuthAuthorizationCode) *OAuthAuthorizationCodeModel {
+	return &OAuthAuthorizationCodeModel{
+		ID:            code.ID,
+		CodeHash:      code.CodeHash,
+		ClientID:      code.ClientID,
+		UserID:        code.UserID,
+		RedirectURI:   code.RedirectURI,
+		Scopes:        entity.FormatScope(code.Scopes),
+		CodeChallenge: code.CodeChallenge,
+		Nonce:         code.Nonce,
+		ExpiresAt:     code.ExpiresAt,
+		UsedAt:        code.UsedAt,
+		SessionID:     code.SessionID,
+		CreatedAt:     code.CreatedAt,
+	}
+}
+
+func (r *OAuthAuthorizationCodeRepository) toEntity(model *OAuthAuthorizationCodeModel) *entity.OAuthAuthorizationCode {
+	return &entity.OAuthAuthorizationCode{
+		ID:            model.ID,
+		CodeHash:      model.CodeHash,
+		ClientID:      model.ClientID,
+		UserID:        model.UserID,
+		RedirectURI:   model.RedirectURI,
+		Scopes:        entity.ParseScope(model.Scopes),
+		CodeChallenge: model.CodeChallenge,
+		Nonce:         model.Nonce,
+		ExpiresAt:     model.ExpiresAt,
+		UsedAt:        model.UsedAt,
+		SessionID:     model.SessionID,
+		CreatedAt:     model.CreatedAt,
+	}
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OAuthClientModel stores redirect URIs and scopes space-separated; neither
+// can contain a space.
+type OAuthClientModel struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name         string    `gorm:"not null"`
+	SecretHash   string
+	RedirectURIs string    `gorm:"not null"`
+	Scopes       string    `gorm:"not null"`
+	FirstParty   bool      `gorm:"not null;default:false"`
+	Machine      bool      `gorm:"not null;default:false"`
+	CreatedBy    uuid.UUID `gorm:"type:uuid"`
+	CreatedAt    time.Time
+}
+
+func (OAuthClientModel) TableName() string {
+	return "oauth_clients"
+}
+
+type OAuthClientRepository struct {
+	db *gorm.DB
+}
+
+func NewOAuthClientRepository(db *gorm.DB) *OAuthClientRepository {
+	return &OAuthClientRepository{db: db}
+}
+
+func (r *OAuthClientRepository) Create(ctx context.Context, client *entity.OAuthClient) error {
+	if err := r.db.WithContext(ctx).Create(r.toModel(client)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *OAuthClientRepository) Save(ctx context.Context, client *entity.OAuthClient) error {
+	// Replicas provision the same clients at startup, so an insert can race.
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "secret_hash", "redirect_uris", "scopes", "first_party", "machine"}),
+		}).
+		Create(r.toModel(client)).Error
+	if err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *OAuthClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.OAuthClient, error) {
+	var model OAuthClientModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrOAuthClientNotFound
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *OAuthClientRepository) List(ctx context.Context) ([]*entity.OAuthClient, error) {
+	var models []OAuthClientModel
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	clients := make([]*entity.OAuthClient, len(models))
+	for i, model := range models {
+		clients[i] = r.toEntity(&model)
+	}
+	return clients, nil
+}
+
+func (r *OAuthClientRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&OAuthClientModel{})
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+func (r *OAuthClientRepository) toModel(client *entity.OAuthClient) *OAuthClientModel {
+	return &OAuthClientModel{
+		ID:           client.ID,
+		Name:         client.Name,
+		SecretHash:   client.SecretHash,
+		RedirectURIs: strings.Join(client.RedirectURIs, " "),
+		Scopes:       entity.FormatScope(client.Scopes),
+		FirstParty:   client.FirstParty,
+		Machine:      client.Machine,
+		CreatedBy:    client.CreatedBy,
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+func (r *OAuthClientRepository) toEntity(model *OAuthClientModel) *entity.OAuthClient {
+	return &entity.OAuthClient{
+		ID:           model.ID,
+		Name:         model.Name,
+		SecretHash:   model.SecretHash,
+		RedirectURIs: strings.Fields(model.RedirectURIs),
+		Scopes:       entity.ParseScope(model.Scopes),
+		FirstParty:   model.FirstParty,
+		Machine:      model.Machine,
+		CreatedBy:    model.CreatedBy,
+		CreatedAt:    model.CreatedAt,
+	}
+}
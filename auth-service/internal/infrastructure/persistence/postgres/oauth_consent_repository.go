@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OAuthConsentModel struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_oauth_consents_user_client"`
+	ClientID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_oauth_consents_user_client"`
+	Scopes    string    `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (OAuthConsentModel) TableName() string {
+	return "oauth_consents"
+}
+
+type OAuthConsentRepository struct {
+	db *gorm.DB
+}
+
+func NewOAuthConsentRepository(db *gorm.DB) *OAuthConsentRepository {
+	return &OAuthConsentRepository{db: db}
+}
+
+func (r *OAuthConsentRepository) Find(ctx context.Context, userID, clientID uuid.UUID) (*entity.OAuthConsent, error) {
+	var model OAuthConsentModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND client_id = ?", userID, clientID).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrOAuthConsentNotFound
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *OAuthConsentRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.OAuthConsent, error) {
+	var models []OAuthConsentModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	consents := make([]*entity.OAuthConsent, len(models))
+	for i, model := range models {
+		consents[i] = r.toEntity(&model)
+	}
+	return consents, nil
+}
+
+func (r *OAuthConsentRepository) Save(ctx context.Context, consent *entity.OAuthConsent) error {
+	if err := r.db.WithContext(ctx).Save(r.toModel(consent)).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *OAuthConsentRepository) Delete(ctx context.Context, userID, clientID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND client_id = ?", userID, clientID).
+		Delete(&OAuthConsentModel{})
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrOAuthConsentNotFound
+	}
+	return nil
+}
+
+func (r *OAuthConsentRepository) toModel(consent *entity.OAuthConsent) *OAuthConsentModel {
+	return &OAuthConsentModel{
+		ID:        consent.ID,
+		UserID:    consent.UserID,
+		ClientID:  consent.ClientID,
+		Scopes:    entity.FormatScope(consent.Scopes),
+		CreatedAt: consent.CreatedAt,
+		UpdatedAt: consent.UpdatedAt,
+	}
+}
+
+func (r *OAuthConsentRepository) toEntity(model *OAuthConsentModel) *entity.OAuthConsent {
+	return &entity.OAuthConsent{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		ClientID:  model.ClientID,
+		Scopes:    entity.ParseScope(model.Scopes),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
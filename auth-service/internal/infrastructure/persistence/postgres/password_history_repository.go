@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PasswordHistoryModel struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index:idx_password_history_user_created,priority:1"`
+	PasswordHash string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"index:idx_password_history_user_created,priority:2"`
+}
+
+func (PasswordHistoryModel) TableName() string {
+	return "password_history"
+}
+
+type PasswordHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordHistoryRepository(db *gorm.DB) *PasswordHistoryRepository {
+	return &PasswordHistoryRepository{db: db}
+}
+
+func (r *PasswordHistoryRepository) Create(ctx context.Context, entry *entity.PasswordHistory) error {
+	model := &PasswordHistoryModel{
+		ID:           entry.ID,
+		UserID:       entry.UserID,
+		PasswordHash: entry.PasswordHash,
+		CreatedAt:    entry.CreatedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *PasswordHistoryRepository) ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]*entity.PasswordHistory, error) {
+	var models []PasswordHistoryModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	entries := make([]*entity.PasswordHistory, len(models))
+	for i, model := range models {
+		entries[i] = &entity.PasswordHistory{
+			ID:           model.ID,
+			UserID:       model.UserID,
+			PasswordHash: model.PasswordHash,
+			CreatedAt:    model.CreatedAt,
+		}
+	}
+	return entries, nil
+}
+
+func (r *PasswordHistoryRepository) Prune(ctx context.Context, userID uuid.UUID, keep int) error {
+	newest := r.db.Model(&PasswordHistoryModel{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(keep)
+
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND id NOT IN (?)", userID, newest).
+		Delete(&PasswordHistoryModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
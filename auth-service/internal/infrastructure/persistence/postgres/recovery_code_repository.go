@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeModel struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	CodeHash  string    `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (RecoveryCodeModel) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+type RecoveryCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{db: db}
+}
+
+func (r *RecoveryCodeRepository) ReplaceAll(ctx context.Context, userID uuid.UUID, codes []*entity.RecoveryCode) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&RecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		if len(codes) == 0 {
+			return nil
+		}
+		models := make([]RecoveryCodeModel, len(codes))
+		for i, code := range codes {
+			models[i] = *r.toModel(code)
+		}
+		return tx.Create(&models).Error
+	})
+	if err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+// Consume marks an unused recovery code as used. Concurrent attempts with the
+// same code are serialised by the conditional update.
+func (r *RecoveryCodeRepository) Consume(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	result := r.db.WithContext(ctx).
+		Model(&RecoveryCodeModel{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (r *RecoveryCodeRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&RecoveryCodeModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *RecoveryCodeRepository) toModel(code *entity.RecoveryCode) *RecoveryCodeModel {
+	return &RecoveryCodeModel{
+		ID:        code.ID,
+		UserID:    code.UserID,
+		CodeHash:  code.CodeHash,
+		UsedAt:    code.UsedAt,
+		CreatedAt: code.CreatedAt,
+	}
+}
@@ -21,6 +21,14 @@ type RefreshTokenModel struct {
 	IsRevoked     bool       `gorm:"not null;default:false"`
 	CreatedAt     time.Time
 	RevokedAt     *time.Time
+	ReplacedByID  *uuid.UUID `gorm:"type:uuid"`
+
+	IPAddress        string
+	UserAgent        string
+	SessionStartedAt *time.Time
+
+	ClientID *uuid.UUID `gorm:"type:uuid;index"`
+	Scope    string
 }
 
 func (RefreshTokenModel) TableName() string {
@@ -111,15 +119,52 @@ func (r *RefreshTokenRepository) RevokeByTokenFamilyID(ctx context.Context, fami
 	return nil
 }
 
-func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+func (r *RefreshTokenRepository) RevokeByClientID(ctx context.Context, userID, clientID uuid.UUID) error {
+	now := time.Now()
 	if err := r.db.WithContext(ctx).
-		Where("expires_at < ?", time.Now()).
-		Delete(&RefreshTokenModel{}).Error; err != nil {
+		Model(&RefreshTokenModel{}).
+		Where("user_id = ? AND client_id = ? AND is_revoked = ?", userID, clientID, false).
+		Updates(map[string]interface{}{
+			"is_revoked": true,
+			"revoked_at": now,
+		}).Error; err != nil {
 		return domainErr.ErrDatabase
 	}
 	return nil
 }
 
+// MarkRotated revokes a token as part of rotation and links it to its
+// successor. It returns ErrTokenRevoked if the token was already revoked, which
+// happens when two requests race to rotate the same token.
+func (r *RefreshTokenRepository) MarkRotated(ctx context.Context, id, replacedByID uuid.UUID) error {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("id = ? AND is_revoked = ?", id, false).
+		Updates(map[string]interface{}{
+			"is_revoked":     true,
+			"revoked_at":     now,
+			"replaced_by_id": replacedByID,
+		})
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrTokenRevoked
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&RefreshTokenModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
 func (r *RefreshTokenRepository) toModel(token *entity.RefreshToken) *RefreshTokenModel {
 	return &RefreshTokenModel{
 		ID:            token.ID,
@@ -130,6 +175,14 @@ func (r *RefreshTokenRepository) toModel(token *entity.RefreshToken) *RefreshTok
 		IsRevoked:     token.IsRevoked,
 		CreatedAt:     token.CreatedAt,
 		RevokedAt:     token.RevokedAt,
+		ReplacedByID:  token.ReplacedByID,
+
+		IPAddress:        token.IPAddress,
+		UserAgent:        token.UserAgent,
+		SessionStartedAt: &token.SessionStartedAt,
+
+		ClientID: token.ClientID,
+		Scope:    token.Scope,
 	}
 }
 
@@ -138,6 +191,11 @@ func (r *RefreshTokenRepository) toEntity(model *RefreshTokenModel) *entity.Refr
 	if model.TokenFamilyID != nil {
 		familyID = *model.TokenFamilyID
 	}
+	// Tokens issued before sessions were tracked have no start time.
+	sessionStartedAt := model.CreatedAt
+	if model.SessionStartedAt != nil {
+		sessionStartedAt = *model.SessionStartedAt
+	}
 	return &entity.RefreshToken{
 		ID:            model.ID,
 		UserID:        model.UserID,
@@ -147,5 +205,13 @@ func (r *RefreshTokenRepository) toEntity(model *RefreshTokenModel) *entity.Refr
 		IsRevoked:     model.IsRevoked,
 		CreatedAt:     model.CreatedAt,
 		RevokedAt:     model.RevokedAt,
+		ReplacedByID:  model.ReplacedByID,
+
+		IPAddress:        model.IPAddress,
+		UserAgent:        model.UserAgent,
+		SessionStartedAt: sessionStartedAt,
+
+		ClientID: model.ClientID,
+		Scope:    model.Scope,
 	}
 }
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"gorm.io/gorm"
+)
+
+type SigningKeyModel struct {
+	ID            string    `gorm:"primaryKey"`
+	Algorithm     string    `gorm:"not null"`
+	Status        string    `gorm:"not null;index"`
+	PrivateKeyPEM string    `gorm:"not null"`
+	PublicKeyPEM  string    `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+	ActivatedAt   *time.Time
+	RetiresAt     *time.Time
+}
+
+func (SigningKeyModel) TableName() string {
+	return "signing_keys"
+}
+
+type SigningKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewSigningKeyRepository(db *gorm.DB) *SigningKeyRepository {
+	return &SigningKeyRepository{db: db}
+}
+
+func (r *SigningKeyRepository) Create(ctx context.Context, key *entity.SigningKey) error {
+	model := r.toModel(key)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *SigningKeyRepository) FindAll(ctx context.Context) ([]*entity.SigningKey, error) {
+	var models []SigningKeyModel
+	if err := r.db.WithContext(ctx).Order("created_at ASC").Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
+	}
+
+	keys := make([]*entity.SigningKey, len(models))
+	for i, model := range models {
+		keys[i] = r.toEntity(&model)
+	}
+	return keys, nil
+}
+
+func (r *SigningKeyRepository) Rotate(ctx context.Context, activeID, nextID string, retiresAt time.Time, newNext *entity.SigningKey) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&SigningKeyModel{}).
+			Where("id = ? AND status = ?", activeID, string(entity.KeyStatusActive)).
+			Updates(map[string]interface{}{
+				"status":     string(entity.KeyStatusRetired),
+				"retires_at": retiresAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErr.ErrKeyRotationConflict
+		}
+
+		result = tx.Model(&SigningKeyModel{}).
+			Where("id = ? AND status = ?", nextID, string(entity.KeyStatusNext)).
+			Updates(map[string]interface{}{
+				"status":       string(entity.KeyStatusActive),
+				"activated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainErr.ErrKeyRotationConflict
+		}
+
+		return tx.Create(r.toModel(newNext)).Error
+	})
+	if errors.Is(err, domainErr.ErrKeyRotationConflict) {
+		return err
+	}
+	if err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *SigningKeyRepository) DeleteRetiredBefore(ctx context.Context, before time.Time) error {
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND retires_at < ?", string(entity.KeyStatusRetired), before).
+		Delete(&SigningKeyModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *SigningKeyRepository) toModel(key *entity.SigningKey) *SigningKeyModel {
+	return &SigningKeyModel{
+		ID:            key.ID,
+		Algorithm:     key.Algorithm,
+		Status:        string(key.Status),
+		PrivateKeyPEM: key.PrivateKeyPEM,
+		PublicKeyPEM:  key.PublicKeyPEM,
+		CreatedAt:     key.CreatedAt,
+		ActivatedAt:   key.ActivatedAt,
+		RetiresAt:     key.RetiresAt,
+	}
+}
+
+func (r *SigningKeyRepository) toEntity(model *SigningKeyModel) *entity.SigningKey {
+	return &entity.SigningKey{
+		ID:            model.ID,
+		Algorithm:     model.Algorithm,
+		Status:        entity.KeyStatus(model.Status),
+		PrivateKeyPEM: model.PrivateKeyPEM,
+		PublicKeyPEM:  model.PublicKeyPEM,
+		CreatedAt:     model.CreatedAt,
+		ActivatedAt:   model.ActivatedAt,
+		RetiresAt:     model.RetiresAt,
+	}
+}
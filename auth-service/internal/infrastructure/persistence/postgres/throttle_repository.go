@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ThrottleEventModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	AttemptID  uuid.UUID `gorm:"type:uuid;index"`
+	Key        string    `gorm:"not null;index:idx_throttle_events_key_occurred,priority:1"`
+	OccurredAt time.Time `gorm:"not null;index:idx_throttle_events_key_occurred,priority:2;index"`
+}
+
+func (ThrottleEventModel) TableName() string {
+	return "throttle_events"
+}
+
+// ThrottleRepository keeps throttle events in Postgres so that every replica
+// counts the same attempts.
+type ThrottleRepository struct {
+	db *gorm.DB
+}
+
+func NewThrottleRepository(db *gorm.DB) *ThrottleRepository {
+	return &ThrottleRepository{db: db}
+}
+
+func (r *ThrottleRepository) Attempt(ctx context.Context, attemptID uuid.UUID, keys []repository.ThrottleKey, at time.Time, decide func([]repository.ThrottleWindow) time.Duration) (time.Duration, error) {
+	// Transaction-level advisory locks serialise attempts on the same keys
+	// until the events are committed. They are taken in sorted order so
+	// that attempts sharing several keys cannot deadlock.
+	locks := make([]string, len(keys))
+	for i, k := range keys {
+		locks[i] = "throttle:" + k.Key
+	}
+	sort.Strings(locks)
+
+	var wait time.Duration
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, lock := range locks {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", lock).Error; err != nil {
+				return err
+			}
+		}
+
+		windows := make([]repository.ThrottleWindow, len(keys))
+		for i, k := range keys {
+			window, err := r.window(tx, k.Key, k.Since)
+			if err != nil {
+				return err
+			}
+			windows[i] = window
+		}
+		if wait = decide(windows); wait > 0 || len(keys) == 0 {
+			return nil
+		}
+
+		events := make([]ThrottleEventModel, len(keys))
+		for i, k := range keys {
+			events[i] = ThrottleEventModel{ID: uuid.New(), AttemptID: attemptID, Key: k.Key, OccurredAt: at}
+		}
+		return tx.Create(&events).Error
+	})
+	if err != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return wait, nil
+}
+
+func (r *ThrottleRepository) window(tx *gorm.DB, key string, since time.Time) (repository.ThrottleWindow, error) {
+	var row struct {
+		Count int
+		First *time.Time
+		Last  *time.Time
+	}
+	if err := tx.Model(&ThrottleEventModel{}).
+		Select("COUNT(*) AS count, MIN(occurred_at) AS first, MAX(occurred_at) AS last").
+		Where("key = ? AND occurred_at > ?", key, since).
+		Scan(&row).Error; err != nil {
+		return repository.ThrottleWindow{}, err
+	}
+
+	window := repository.ThrottleWindow{Count: row.Count}
+	if row.First != nil && row.Last != nil {
+		window.First, window.Last = *row.First, *row.Last
+	}
+	return window, nil
+}
+
+func (r *ThrottleRepository) Forget(ctx context.Context, attemptID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("attempt_id = ?", attemptID).Delete(&ThrottleEventModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *ThrottleRepository) Clear(ctx context.Context, key string) error {
+	if err := r.db.WithContext(ctx).Where("key = ?", key).Delete(&ThrottleEventModel{}).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *ThrottleRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("occurred_at < ?", before).
+		Delete(&ThrottleEventModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
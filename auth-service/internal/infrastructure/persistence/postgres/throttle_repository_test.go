@@ -0,0 +1,76 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentAttemptsCountEachOther(t *testing.T) {
+	ctx := context.Background()
+	repo := NewThrottleRepository(newTestDB(t))
+	keys := []repository.ThrottleKey{{Key: "test:" + uuid.NewString(), Since: time.Now().Add(-time.Hour)}}
+
+	// Each attempt may go ahead while fewer than three are recorded.
+	decide := func(windows []repository.ThrottleWindow) time.Duration {
+		if windows[0].Count >= 3 {
+			return time.Minute
+		}
+		return 0
+	}
+
+	const callers = 10
+	waits := make([]time.Duration, callers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range waits {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			wait, err := repo.Attempt(ctx, uuid.New(), keys, time.Now(), decide)
+			require.NoError(t, err)
+			waits[i] = wait
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	allowed := 0
+	for _, wait := range waits {
+		if wait == 0 {
+			allowed++
+		}
+	}
+	require.Equal(t, 3, allowed)
+}
+
+func TestForgetDeletesTheAttemptsEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewThrottleRepository(newTestDB(t))
+	key := "test:" + uuid.NewString()
+	keys := []repository.ThrottleKey{{Key: key, Since: time.Now().Add(-time.Hour)}}
+
+	var counted int
+	count := func(windows []repository.ThrottleWindow) time.Duration {
+		counted = windows[0].Count
+		return 0
+	}
+
+	attemptID := uuid.New()
+	_, err := repo.Attempt(ctx, attemptID, keys, time.Now(), count)
+	require.NoError(t, err)
+	require.NoError(t, repo.Forget(ctx, attemptID))
+
+	_, err = repo.Attempt(ctx, uuid.New(), keys, time.Now(), count)
+	require.NoError(t, err)
+	require.Zero(t, counted)
+}
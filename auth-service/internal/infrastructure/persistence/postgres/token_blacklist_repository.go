@@ -2,7 +2,6 @@ package postgres
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"auth-service/internal/domain/entity"
@@ -16,7 +15,7 @@ type TokenBlacklistModel struct {
 	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
 	TokenHash string    `gorm:"uniqueIndex;not null"`
 	ExpiresAt time.Time `gorm:"not null;index"`
-	CreatedAt time.Time
+	CreatedAt time.Time `gorm:"index"`
 }
 
 func (TokenBlacklistModel) TableName() string {
@@ -50,16 +49,31 @@ func (r *TokenBlacklistRepository) IsBlacklisted(ctx context.Context, tokenHash
 	return count > 0, nil
 }
 
-func (r *TokenBlacklistRepository) DeleteExpired(ctx context.Context) error {
+func (r *TokenBlacklistRepository) FindCreatedSince(ctx context.Context, since time.Time, limit int) ([]*entity.TokenBlacklist, error) {
+	var models []TokenBlacklistModel
 	if err := r.db.WithContext(ctx).
-		Where("expires_at < ?", time.Now()).
-		Delete(&TokenBlacklistModel{}).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil
-		}
-		return domainErr.ErrDatabase
+		Where("created_at >= ? AND expires_at > ?", since, time.Now()).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabase
 	}
-	return nil
+
+	entries := make([]*entity.TokenBlacklist, len(models))
+	for i, model := range models {
+		entries[i] = r.toEntity(&model)
+	}
+	return entries, nil
+}
+
+func (r *TokenBlacklistRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&TokenBlacklistModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
 }
 
 func (r *TokenBlacklistRepository) toModel(blacklist *entity.TokenBlacklist) *TokenBlacklistModel {
@@ -70,3 +84,12 @@ func (r *TokenBlacklistRepository) toModel(blacklist *entity.TokenBlacklist) *To
 		CreatedAt: blacklist.CreatedAt,
 	}
 }
+
+func (r *TokenBlacklistRepository) toEntity(model *TokenBlacklistModel) *entity.TokenBlacklist {
+	return &entity.TokenBlacklist{
+		ID:        model.ID,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
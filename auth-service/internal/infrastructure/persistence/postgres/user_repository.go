@@ -3,28 +3,37 @@ package postgres
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"auth-service/internal/domain/entity"
 	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type UserModel struct {
-	ID                  uuid.UUID `gorm:"type:uuid;primaryKey"`
-	Email               string    `gorm:"uniqueIndex;not null"`
-	PasswordHash        string    `gorm:"not null"`
-	Role                string    `gorm:"not null;default:'user'"`
-	IsVerified          bool      `gorm:"not null;default:false"`
-	IsActive            bool      `gorm:"not null;default:true"`
-	FailedLoginAttempts int       `gorm:"not null;default:0"`
-	LockedUntil         *time.Time
-	LastLoginAt         *time.Time
-	LastLoginIP         string
-	CreatedAt           time.Time
-	UpdatedAt           time.Time
+	ID                    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Email                 string    `gorm:"uniqueIndex;not null"`
+	PasswordHash          string    `gorm:"not null"`
+	Role                  string    `gorm:"not null;default:'user'"`
+	IsVerified            bool      `gorm:"not null;default:false"`
+	IsActive              bool      `gorm:"not null;default:true"`
+	FailedLoginAttempts   int       `gorm:"not null;default:0"`
+	LockedUntil           *time.Time
+	LastLoginAt           *time.Time
+	LastLoginIP           string
+	MFAEnabled            bool `gorm:"not null;default:false"`
+	MFASecret             string
+	MFALastUsedStep       int64 `gorm:"not null;default:0"`
+	PasswordResetRequired bool  `gorm:"not null;default:false"`
+	PasswordChangedAt     *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	// DeletedAt is set when the account is deleted; lookups skip such users.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (UserModel) TableName() string {
@@ -71,12 +80,30 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity
 
 func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	model := r.toModel(user)
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+	// Selecting the columns stops Save from inserting the row when no row is
+	// updated, so a stale copy cannot bring a deleted user back.
+	if err := r.db.WithContext(ctx).Select("*").Save(model).Error; err != nil {
 		return domainErr.ErrDatabase
 	}
 	return nil
 }
 
+func (r *UserRepository) UseMFAStep(ctx context.Context, id uuid.UUID, step int64) error {
+	result := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("id = ? AND mfa_last_used_step < ?", id, step).
+		Updates(map[string]interface{}{
+			"mfa_last_used_step": step,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrInvalidMFACode
+	}
+	return nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if err := r.db.WithContext(ctx).Delete(&UserModel{}, "id = ?", id).Error; err != nil {
 		return domainErr.ErrDatabase
@@ -92,36 +119,92 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 	return count > 0, nil
 }
 
+func (r *UserRepository) List(ctx context.Context, filter repository.UserFilter, limit, offset int) ([]*entity.User, int64, error) {
+	query := r.db.WithContext(ctx).Model(&UserModel{})
+
+	if filter.Query != "" {
+		query = query.Where("email ILIKE ?", "%"+escapeLike(filter.Query)+"%")
+	}
+	if filter.Role != nil {
+		query = query.Where("role = ?", string(*filter.Role))
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+	if filter.Locked != nil {
+		if *filter.Locked {
+			query = query.Where("locked_until > ?", time.Now())
+		} else {
+			query = query.Where("locked_until IS NULL OR locked_until <= ?", time.Now())
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, domainErr.ErrDatabase
+	}
+
+	var models []UserModel
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&models).Error; err != nil {
+		return nil, 0, domainErr.ErrDatabase
+	}
+
+	users := make([]*entity.User, len(models))
+	for i, model := range models {
+		users[i] = r.toEntity(&model)
+	}
+	return users, total, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (r *UserRepository) toModel(user *entity.User) *UserModel {
 	return &UserModel{
-		ID:                  user.ID,
-		Email:               user.Email,
-		PasswordHash:        user.PasswordHash,
-		Role:                string(user.Role),
-		IsVerified:          user.IsVerified,
-		IsActive:            user.IsActive,
-		FailedLoginAttempts: user.FailedLoginAttempts,
-		LockedUntil:         user.LockedUntil,
-		LastLoginAt:         user.LastLoginAt,
-		LastLoginIP:         user.LastLoginIP,
-		CreatedAt:           user.CreatedAt,
-		UpdatedAt:           user.UpdatedAt,
+		ID:                    user.ID,
+		Email:                 user.Email,
+		PasswordHash:          user.PasswordHash,
+		Role:                  string(user.Role),
+		IsVerified:            user.IsVerified,
+		IsActive:              user.IsActive,
+		FailedLoginAttempts:   user.FailedLoginAttempts,
+		LockedUntil:           user.LockedUntil,
+		LastLoginAt:           user.LastLoginAt,
+		LastLoginIP:           user.LastLoginIP,
+		MFAEnabled:            user.MFAEnabled,
+		MFASecret:             user.MFASecret,
+		MFALastUsedStep:       user.MFALastUsedStep,
+		PasswordResetRequired: user.PasswordResetRequired,
+		PasswordChangedAt:     user.PasswordChangedAt,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
 	}
 }
 
 func (r *UserRepository) toEntity(model *UserModel) *entity.User {
 	return &entity.User{
-		ID:                  model.ID,
-		Email:               model.Email,
-		PasswordHash:        model.PasswordHash,
-		Role:                entity.Role(model.Role),
-		IsVerified:          model.IsVerified,
-		IsActive:            model.IsActive,
-		FailedLoginAttempts: model.FailedLoginAttempts,
-		LockedUntil:         model.LockedUntil,
-		LastLoginAt:         model.LastLoginAt,
-		LastLoginIP:         model.LastLoginIP,
-		CreatedAt:           model.CreatedAt,
-		UpdatedAt:           model.UpdatedAt,
+		ID:                    model.ID,
+		Email:                 model.Email,
+		PasswordHash:          model.PasswordHash,
+		Role:                  entity.Role(model.Role),
+		IsVerified:            model.IsVerified,
+		IsActive:              model.IsActive,
+		FailedLoginAttempts:   model.FailedLoginAttempts,
+		LockedUntil:           model.LockedUntil,
+		LastLoginAt:           model.LastLoginAt,
+		LastLoginIP:           model.LastLoginIP,
+		MFAEnabled:            model.MFAEnabled,
+		MFASecret:             model.MFASecret,
+		MFALastUsedStep:       model.MFALastUsedStep,
+		PasswordResetRequired: model.PasswordResetRequired,
+		PasswordChangedAt:     model.PasswordChangedAt,
+		CreatedAt:             model.CreatedAt,
+		UpdatedAt:             model.UpdatedAt,
 	}
 }
@@ -0,0 +1,48 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentUseMFAStepLetsOneSucceed(t *testing.T) {
+	ctx := context.Background()
+	users := NewUserRepository(newTestDB(t))
+	user := createTestUser(t, users)
+
+	const callers = 8
+	errs := make([]error, callers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = users.UseMFAStep(ctx, user.ID, 100)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, domainErr.ErrInvalidMFACode)
+	}
+	require.Equal(t, 1, succeeded)
+
+	// Earlier steps are spent as well.
+	require.ErrorIs(t, users.UseMFAStep(ctx, user.ID, 99), domainErr.ErrInvalidMFACode)
+	require.NoError(t, users.UseMFAStep(ctx, user.ID, 101))
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VerificationTokenModel struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	Purpose   string    `gorm:"not null;index"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (VerificationTokenModel) TableName() string {
+	return "verification_tokens"
+}
+
+type VerificationTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewVerificationTokenRepository(db *gorm.DB) *VerificationTokenRepository {
+	return &VerificationTokenRepository{db: db}
+}
+
+func (r *VerificationTokenRepository) Create(ctx context.Context, token *entity.VerificationToken) error {
+	model := r.toModel(token)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string, purpose entity.TokenPurpose) (*entity.VerificationToken, error) {
+	var model VerificationTokenModel
+	if err := r.db.WithContext(ctx).
+		Where("token_hash = ? AND purpose = ?", tokenHash, string(purpose)).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidToken
+		}
+		return nil, domainErr.ErrDatabase
+	}
+	return r.toEntity(&model), nil
+}
+
+// MarkUsed consumes the token. It fails with ErrInvalidToken when the token
+// was already consumed, so two concurrent requests cannot both succeed.
+func (r *VerificationTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return domainErr.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrInvalidToken
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) InvalidateByUserID(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose) error {
+	if err := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, string(purpose)).
+		Update("used_at", time.Now()).Error; err != nil {
+		return domainErr.ErrDatabase
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&VerificationTokenModel{})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *VerificationTokenRepository) toModel(token *entity.VerificationToken) *VerificationTokenModel {
+	return &VerificationTokenModel{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		TokenHash: token.TokenHash,
+		Purpose:   string(token.Purpose),
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
+
+func (r *VerificationTokenRepository) toEntity(model *VerificationTokenModel) *entity.VerificationToken {
+	return &entity.VerificationToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		TokenHash: model.TokenHash,
+		Purpose:   entity.TokenPurpose(model.Purpose),
+		ExpiresAt: model.ExpiresAt,
+		UsedAt:    model.UsedAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkUsedSucceedsOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVerificationTokenRepository(newTestDB(t))
+
+	token := entity.NewVerificationToken(uuid.New(), uuid.NewString(), entity.TokenPurposeEmailVerification, time.Now().Add(time.Hour))
+	require.NoError(t, repo.Create(ctx, token))
+
+	require.NoError(t, repo.MarkUsed(ctx, token.ID))
+	require.ErrorIs(t, repo.MarkUsed(ctx, token.ID), domainErr.ErrInvalidToken)
+
+	stored, err := repo.FindByTokenHash(ctx, token.TokenHash, entity.TokenPurposeEmailVerification)
+	require.NoError(t, err)
+	require.False(t, stored.IsValid())
+}
+
+func TestConcurrentMarkUsedLetsOneSucceed(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVerificationTokenRepository(newTestDB(t))
+
+	token := entity.NewVerificationToken(uuid.New(), uuid.NewString(), entity.TokenPurposePasswordReset, time.Now().Add(time.Hour))
+	require.NoError(t, repo.Create(ctx, token))
+
+	const callers = 8
+	errs := make([]error, callers)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = repo.MarkUsed(ctx, token.ID)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, domainErr.ErrInvalidToken)
+	}
+	require.Equal(t, 1, succeeded)
+}
@@ -0,0 +1,60 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/repository"
+)
+
+// FeedPageSize is the maximum number of revocations returned per feed call.
+const FeedPageSize = 1000
+
+// RepositoryFeed reads revocations straight from the token_blacklist table.
+// It is the auth-service's own feed; other services poll ListRevokedTokens.
+type RepositoryFeed struct {
+	repo repository.TokenBlacklistRepository
+}
+
+func NewRepositoryFeed(repo repository.TokenBlacklistRepository) *RepositoryFeed {
+	return &RepositoryFeed{repo: repo}
+}
+
+func (f *RepositoryFeed) RevokedSince(ctx context.Context, since time.Time) ([]Entry, time.Time, bool, error) {
+	now := time.Now()
+	rows, err := f.repo.FindCreatedSince(ctx, since, FeedPageSize)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	entries := make([]Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = Entry{TokenHash: row.TokenHash, ExpiresAt: row.ExpiresAt}
+	}
+
+	if len(rows) == FeedPageSize {
+		return entries, rows[len(rows)-1].CreatedAt, true, nil
+	}
+	return entries, now, false, nil
+}
+
+// BlacklistRepository adds revocations to the local cache as they are
+// written, so this replica rejects a token from the moment it is revoked
+// instead of after the next sync.
+type BlacklistRepository struct {
+	repository.TokenBlacklistRepository
+	cache *Cache
+}
+
+func NewBlacklistRepository(repo repository.TokenBlacklistRepository, cache *Cache) *BlacklistRepository {
+	return &BlacklistRepository{TokenBlacklistRepository: repo, cache: cache}
+}
+
+func (r *BlacklistRepository) Add(ctx context.Context, blacklist *entity.TokenBlacklist) error {
+	if err := r.TokenBlacklistRepository.Add(ctx, blacklist); err != nil {
+		return err
+	}
+	r.cache.Add(blacklist.TokenHash, blacklist.ExpiresAt)
+	return nil
+}
@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"auth-service/internal/domain/service"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	jobLastRun = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth_scheduler_job_last_run_timestamp_seconds",
+		Help: "Unix time of the last completed run of a scheduled job on this replica.",
+	}, []string{"job"})
+	jobLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auth_scheduler_job_last_success_timestamp_seconds",
+		Help: "Unix time of the last successful run of a scheduled job on this replica.",
+	}, []string{"job"})
+	jobRowsAffected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_scheduler_job_rows_affected_total",
+		Help: "Rows deleted, or items processed, by a scheduled job.",
+	}, []string{"job"})
+	jobFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_scheduler_job_failures_total",
+		Help: "Failed runs of a scheduled job.",
+	}, []string{"job"})
+	jobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "auth_scheduler_job_duration_seconds",
+		Help: "Duration of scheduled job runs.",
+	}, []string{"job"})
+	leader = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "auth_scheduler_leader",
+		Help: "1 if this replica holds the scheduler's leader lock and runs its jobs, else 0.",
+	})
+)
+
+// leaderLockName names the lock that elects the replica running the jobs.
+const leaderLockName = "auth-service:scheduler"
+
+// Job is a periodic task. Run returns the number of rows it affected: those
+// it deleted, or for jobs that do not delete, the items it processed.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) (int64, error)
+}
+
+// Scheduler runs each registered job on every interval, but only on the
+// replica that holds the scheduler's leader lock. One lock covers every job,
+// so the leader holds a single database connection for it. Other replicas
+// try to take the lock as often as the most frequent job runs, so the jobs
+// move to another replica when their leader stops.
+type Scheduler struct {
+	lock service.LeaderLock
+	log  *zap.Logger
+	jobs []Job
+}
+
+func New(lock service.LeaderLock, log *zap.Logger) *Scheduler {
+	return &Scheduler{lock: lock, log: log}
+}
+
+// Register adds a job. Jobs with a non-positive interval are disabled.
+func (s *Scheduler) Register(job Job) {
+	if job.Interval <= 0 {
+		s.log.Info("scheduled job disabled", zap.String("job", job.Name))
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Run competes for leadership and runs the jobs while this replica leads. It
+// blocks until ctx is cancelled, every job has stopped and the lock is
+// released.
+func (s *Scheduler) Run(ctx context.Context) {
+	if len(s.jobs) == 0 {
+		return
+	}
+	leader.Set(0)
+
+	interval := s.jobs[0].Interval
+	for _, job := range s.jobs[1:] {
+		if job.Interval < interval {
+			interval = job.Interval
+		}
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		lease, ok, err := s.lock.TryAcquire(ctx, leaderLockName)
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				s.log.Error("failed to acquire scheduler lock", zap.Error(err))
+			}
+		case ok:
+			s.lead(ctx, lease, ticker)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs the jobs until ctx is cancelled or the lease is lost, which is
+// checked on every tick.
+func (s *Scheduler) lead(ctx context.Context, lease service.LeaderLease, ticker *time.Ticker) {
+	s.log.Info("acquired scheduler leadership")
+	leader.Set(1)
+
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			s.runJob(jobsCtx, job)
+		}(job)
+	}
+	defer func() {
+		stopJobs()
+		wg.Wait()
+		lease.Release()
+		leader.Set(0)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := lease.Check(ctx); err != nil {
+			if ctx.Err() == nil {
+				s.log.Warn("lost scheduler leadership", zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// runJob runs job now and then on every interval until ctx is cancelled, so
+// long intervals are not delayed by a change of leader.
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	log := s.log.With(zap.String("job", job.Name))
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(ctx, log, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, log *zap.Logger, job Job) {
+	start := time.Now()
+	rows, err := job.Run(ctx)
+	now := time.Now()
+
+	jobDuration.WithLabelValues(job.Name).Observe(now.Sub(start).Seconds())
+	jobLastRun.WithLabelValues(job.Name).Set(float64(now.Unix()))
+
+	if err != nil {
+		jobFailures.WithLabelValues(job.Name).Inc()
+		log.Error("scheduled job failed", zap.Error(err))
+		return
+	}
+
+	jobLastSuccess.WithLabelValues(job.Name).Set(float64(now.Unix()))
+	jobRowsAffected.WithLabelValues(job.Name).Add(float64(rows))
+	log.Info("scheduled job completed", zap.Int64("rows_affected", rows), zap.Duration("duration", now.Sub(start)))
+}
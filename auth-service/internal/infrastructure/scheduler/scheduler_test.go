@@ -0,0 +1,76 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/service"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// countingLock grants its lock unless held and counts the leases taken.
+type countingLock struct {
+	mu     sync.Mutex
+	held   bool
+	leases int
+}
+
+func (l *countingLock) TryAcquire(ctx context.Context, name string) (service.LeaderLease, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.held {
+		return nil, false, nil
+	}
+	l.leases++
+	return nopLease{}, true, nil
+}
+
+type nopLease struct{}
+
+func (nopLease) Check(ctx context.Context) error { return nil }
+func (nopLease) Release()                        {}
+
+func runFor(s *Scheduler, d time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	s.Run(ctx)
+}
+
+func TestJobsShareOneLease(t *testing.T) {
+	lock := &countingLock{}
+	s := New(lock, zap.NewNop())
+
+	runs := make([]atomic.Int64, 3)
+	for i := range runs {
+		runs := &runs[i]
+		s.Register(Job{Name: "job", Interval: 20 * time.Millisecond, Run: func(context.Context) (int64, error) {
+			runs.Add(1)
+			return 0, nil
+		}})
+	}
+	runFor(s, 200*time.Millisecond)
+
+	require.Equal(t, 1, lock.leases)
+	for i := range runs {
+		require.Positive(t, runs[i].Load())
+	}
+}
+
+func TestFollowerRunsNoJobs(t *testing.T) {
+	lock := &countingLock{held: true}
+	s := New(lock, zap.NewNop())
+
+	var runs atomic.Int64
+	s.Register(Job{Name: "job", Interval: 20 * time.Millisecond, Run: func(context.Context) (int64, error) {
+		runs.Add(1)
+		return 0, nil
+	}})
+	runFor(s, 100*time.Millisecond)
+
+	require.Zero(t, runs.Load())
+}
@@ -0,0 +1,83 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params keeps hashing fast; the cost is not under test.
+var testArgon2Params = Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestArgon2HashRoundTrip(t *testing.T) {
+	s := NewArgon2idPasswordService(testArgon2Params)
+
+	hash, err := s.HashPassword("Tangerine-Orbit-42")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"))
+
+	params, salt, key, err := decodeArgon2idHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, testArgon2Params, params)
+	require.Len(t, salt, argon2SaltLength)
+	require.Len(t, key, argon2KeyLength)
+
+	require.NoError(t, s.VerifyPassword(hash, "Tangerine-Orbit-42"))
+	require.ErrorIs(t, s.VerifyPassword(hash, "Tangerine-Orbit-43"), domainErr.ErrInvalidPassword)
+	require.False(t, s.NeedsRehash(hash))
+}
+
+func TestArgon2RejectsMalformedHashes(t *testing.T) {
+	s := NewArgon2idPasswordService(testArgon2Params)
+	hash, err := s.HashPassword("Tangerine-Orbit-42")
+	require.NoError(t, err)
+	parts := strings.Split(hash, "$")
+
+	for name, malformed := range map[string]string{
+		"empty":            "",
+		"plain text":       "Tangerine-Orbit-42",
+		"argon2i":          strings.Replace(hash, "$argon2id$", "$argon2i$", 1),
+		"other version":    strings.Replace(hash, "$v=19$", "$v=16$", 1),
+		"missing params":   strings.Replace(hash, "$m=64,t=1,p=1$", "$m=64$", 1),
+		"zero iterations":  strings.Replace(hash, "t=1", "t=0", 1),
+		"zero parallelism": strings.Replace(hash, "p=1", "p=0", 1),
+		"bad salt":         strings.Join([]string{"", parts[1], parts[2], parts[3], "!!!", parts[5]}, "$"),
+		"empty hash":       strings.Join([]string{"", parts[1], parts[2], parts[3], parts[4], ""}, "$"),
+		"extra field":      hash + "$extra",
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, s.VerifyPassword(malformed, "Tangerine-Orbit-42"), domainErr.ErrInvalidPassword)
+			require.True(t, s.NeedsRehash(malformed))
+		})
+	}
+}
+
+func TestArgon2VerifiesBcryptHashes(t *testing.T) {
+	s := NewArgon2idPasswordService(testArgon2Params)
+	legacy, err := bcrypt.GenerateFromPassword([]byte("Tangerine-Orbit-42"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	require.NoError(t, s.VerifyPassword(string(legacy), "Tangerine-Orbit-42"))
+	require.ErrorIs(t, s.VerifyPassword(string(legacy), "Tangerine-Orbit-43"), domainErr.ErrInvalidPassword)
+	require.True(t, s.NeedsRehash(string(legacy)))
+}
+
+func TestArgon2NeedsRehashWhenParamsChange(t *testing.T) {
+	hash, err := NewArgon2idPasswordService(testArgon2Params).HashPassword("Tangerine-Orbit-42")
+	require.NoError(t, err)
+
+	for _, params := range []Argon2Params{
+		{Memory: 128, Iterations: 1, Parallelism: 1},
+		{Memory: 64, Iterations: 2, Parallelism: 1},
+		{Memory: 64, Iterations: 1, Parallelism: 2},
+	} {
+		s := NewArgon2idPasswordService(params)
+		require.True(t, s.NeedsRehash(hash), "%+v", params)
+		// Old hashes still verify with their own parameters.
+		require.NoError(t, s.VerifyPassword(hash, "Tangerine-Orbit-42"))
+	}
+}
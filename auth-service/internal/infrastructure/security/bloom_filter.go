@@ -0,0 +1,121 @@
+package security
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"strings"
+)
+
+// BloomFilter is a set that may report false positives but never false
+// negatives. It holds a large breached-password list in a fraction of the
+// memory the passwords themselves would take.
+type BloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+// NewBloomFilter sizes a filter for n entries with the given false-positive
+// rate.
+func NewBloomFilter(n int, falsePositiveRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	size := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/float64(n)*math.Ln2)))
+	return &BloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+func (f *BloomFilter) Add(value string) {
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % f.size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (f *BloomFilter) MightContain(value string) bool {
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % f.size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHashes derives the two hashes the k bit positions are built from
+// (Kirsch-Mitzenmacher double hashing).
+func bloomHashes(value string) (uint64, uint64) {
+	h := fnv.New128a()
+	_, _ = h.Write([]byte(value))
+	sum := h.Sum(nil)
+
+	var h1, h2 uint64
+	for i := 0; i < 8; i++ {
+		h1 = h1<<8 | uint64(sum[i])
+		h2 = h2<<8 | uint64(sum[i+8])
+	}
+	// An even h2 would only ever reach half of the bits for even sizes.
+	return h1, h2 | 1
+}
+
+// commonPasswords is the built-in list of the most common passwords, in
+// lower case, including the variants that meet the default character rules.
+//
+//go:embed common_passwords.txt
+var commonPasswords string
+
+// LoadBreachedPasswords builds a bloom filter from the built-in list of
+// common passwords and, if path is set, a file with one password per line,
+// such as a published breach corpus. The file is read twice, once to size
+// the filter, so it is never held in memory.
+func LoadBreachedPasswords(path string, falsePositiveRate float64) (*BloomFilter, error) {
+	builtin := strings.Fields(commonPasswords)
+	count := len(builtin)
+	if path != "" {
+		if err := scanLines(path, func(string) { count++ }); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := NewBloomFilter(count, falsePositiveRate)
+	for _, password := range builtin {
+		filter.Add(password)
+	}
+	if path != "" {
+		if err := scanLines(path, filter.Add); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+func scanLines(path string, fn func(line string)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open breached password list: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line != "" {
+			fn(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read breached password list: %w", err)
+	}
+	return nil
+}
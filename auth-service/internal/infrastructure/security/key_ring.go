@@ -0,0 +1,364 @@
+package security
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/errors"
+	"auth-service/internal/domain/repository"
+	"auth-service/internal/domain/service"
+
+	"go.uber.org/zap"
+)
+
+// KeyRing keeps the signing keys stored in the database in memory. The
+// active key signs new tokens; the next key and retired keys that are still
+// inside their grace period are only published for verification.
+type KeyRing struct {
+	repo        repository.SigningKeyRepository
+	cipher      service.SecretCipher
+	algorithm   string
+	retireGrace time.Duration
+
+	mu        sync.RWMutex
+	active    *ringKey
+	next      *ringKey
+	published []*ringKey
+}
+
+type ringKey struct {
+	id          string
+	algorithm   string
+	signer      crypto.Signer
+	publicKey   crypto.PublicKey
+	publicPEM   string
+	jwk         service.JSONWebKey
+	activatedAt time.Time
+}
+
+// NewKeyRing creates an empty key ring that generates keys for algorithm;
+// changing the algorithm takes effect at the next rotation. retireGrace is
+// how long a rotated-out key stays published; it must cover the access token
+// TTL plus the reload interval so every token it signed can still be verified.
+func NewKeyRing(repo repository.SigningKeyRepository, cipher service.SecretCipher, algorithm string, retireGrace time.Duration) *KeyRing {
+	return &KeyRing{
+		repo:        repo,
+		cipher:      cipher,
+		algorithm:   algorithm,
+		retireGrace: retireGrace,
+	}
+}
+
+// Bootstrap loads the key ring. On first start the key pair at
+// privateKeyPath becomes the active key, so tokens issued before rotation
+// keep working. The key pair is checked against the algorithm on every
+// start, not only when it is imported, and so is the active stored key when
+// the ring is loaded.
+func (r *KeyRing) Bootstrap(ctx context.Context, privateKeyPath, publicKeyPath string) error {
+	signer, err := r.readKeyPair(privateKeyPath, publicKeyPath)
+	if err != nil {
+		return err
+	}
+
+	keys, err := r.repo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		key, err := r.toEntity(signer)
+		if err != nil {
+			return err
+		}
+		key.Activate()
+		// Another replica may have imported the same key concurrently; the
+		// kid is derived from the key material, so the insert just conflicts.
+		_ = r.repo.Create(ctx, key)
+	}
+
+	return r.Reload(ctx)
+}
+
+// Reload refreshes the in-memory ring from the database and makes sure a
+// next key exists for the following rotation.
+func (r *KeyRing) Reload(ctx context.Context) error {
+	keys, err := r.repo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var active, next *ringKey
+	var published []*ringKey
+	for _, key := range keys {
+		if key.IsRetired() {
+			continue
+		}
+
+		loaded, err := r.loadKey(key)
+		if err != nil {
+			return fmt.Errorf("failed to load signing key %s: %w", key.ID, err)
+		}
+		published = append(published, loaded)
+
+		switch key.Status {
+		case entity.KeyStatusActive:
+			active = loaded
+		case entity.KeyStatusNext:
+			// A next key left over from a previous JWT_ALGORITHM is never
+			// promoted; a new one is generated instead.
+			if next == nil && key.Algorithm == r.algorithm {
+				next = loaded
+			}
+		}
+	}
+
+	if active == nil {
+		return domainErr.ErrNoActiveSigningKey
+	}
+
+	if next == nil {
+		key, err := r.newKey()
+		if err != nil {
+			return err
+		}
+		if err := r.repo.Create(ctx, key); err != nil {
+			return err
+		}
+		next, err = r.loadKey(key)
+		if err != nil {
+			return err
+		}
+		published = append(published, next)
+	}
+
+	r.mu.Lock()
+	r.active = active
+	r.next = next
+	r.published = published
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Rotate retires the active key, promotes the next key and generates a new
+// next key.
+func (r *KeyRing) Rotate(ctx context.Context) error {
+	if err := r.Reload(ctx); err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	active, next := r.active, r.next
+	r.mu.RUnlock()
+	if active == nil || next == nil {
+		return domainErr.ErrNoActiveSigningKey
+	}
+
+	newNext, err := r.newKey()
+	if err != nil {
+		return err
+	}
+
+	if err := r.repo.Rotate(ctx, active.id, next.id, time.Now().Add(r.retireGrace), newNext); err != nil {
+		return err
+	}
+
+	return r.Reload(ctx)
+}
+
+// Run reloads the ring every reloadInterval, rotates the active key once it
+// is older than rotationInterval (0 disables scheduled rotation) and deletes
+// keys whose grace period has passed. It blocks until ctx is cancelled.
+func (r *KeyRing) Run(ctx context.Context, reloadInterval, rotationInterval time.Duration, log *zap.Logger) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := r.Reload(ctx); err != nil {
+			log.Error("failed to reload signing keys", zap.Error(err))
+			continue
+		}
+
+		if rotationInterval > 0 {
+			if active, err := r.Active(); err == nil && time.Since(active.activatedAt) >= rotationInterval {
+				switch err := r.Rotate(ctx); err {
+				case nil:
+					log.Info("rotated signing key")
+				case domainErr.ErrKeyRotationConflict:
+					// Another replica rotated first; the next reload picks it up.
+				default:
+					log.Error("failed to rotate signing key", zap.Error(err))
+				}
+			}
+		}
+
+		if err := r.repo.DeleteRetiredBefore(ctx, time.Now()); err != nil {
+			log.Error("failed to delete retired signing keys", zap.Error(err))
+		}
+	}
+}
+
+func (r *KeyRing) Active() (*ringKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.active == nil {
+		return nil, domainErr.ErrNoActiveSigningKey
+	}
+	return r.active, nil
+}
+
+// VerificationKey returns the published key with the given kid. Tokens
+// without a kid were signed before rotation and are checked against the
+// active key.
+func (r *KeyRing) VerificationKey(kid string) (*ringKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if kid == "" {
+		if r.active == nil {
+			return nil, domainErr.ErrNoActiveSigningKey
+		}
+		return r.active, nil
+	}
+	for _, key := range r.published {
+		if key.id == kid {
+			return key, nil
+		}
+	}
+	return nil, domainErr.ErrInvalidToken
+}
+
+func (r *KeyRing) JWKS() []service.JSONWebKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]service.JSONWebKey, len(r.published))
+	for i, key := range r.published {
+		keys[i] = key.jwk
+	}
+	return keys
+}
+
+// readKeyPair reads the configured key pair and checks that it fits the
+// configured algorithm.
+func (r *KeyRing) readKeyPair(privateKeyPath, publicKeyPath string) (crypto.Signer, error) {
+	privateKeyData, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+	signer, err := parsePrivateKeyPEM(privateKeyData)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKeyType(r.algorithm, signer.Public()); err != nil {
+		return nil, fmt.Errorf("invalid key for JWT_ALGORITHM: %w", err)
+	}
+
+	publicKeyData, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+	publicKey, err := parsePublicKeyPEM(publicKeyData)
+	if err != nil {
+		return nil, err
+	}
+	if !publicKeyEqual(signer.Public(), publicKey) {
+		return nil, fmt.Errorf("public key does not match private key")
+	}
+
+	return signer, nil
+}
+
+func (r *KeyRing) newKey() (*entity.SigningKey, error) {
+	signer, err := generateKeyPair(r.algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(signer)
+}
+
+func (r *KeyRing) toEntity(signer crypto.Signer) (*entity.SigningKey, error) {
+	jwk, err := publicJWK(r.algorithm, signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	kid, err := keyThumbprint(jwk)
+	if err != nil {
+		return nil, err
+	}
+
+	privatePEM, err := encodePrivateKeyPEM(signer)
+	if err != nil {
+		return nil, err
+	}
+	encryptedPrivate, err := r.cipher.Encrypt(privatePEM)
+	if err != nil {
+		return nil, err
+	}
+	publicPEM, err := encodePublicKeyPEM(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewSigningKey(kid, r.algorithm, encryptedPrivate, publicPEM), nil
+}
+
+func (r *KeyRing) loadKey(key *entity.SigningKey) (*ringKey, error) {
+	publicKey, err := parsePublicKeyPEM([]byte(key.PublicKeyPEM))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkKeyType(key.Algorithm, publicKey); err != nil {
+		return nil, err
+	}
+	jwk, err := publicJWK(key.Algorithm, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	jwk.KeyID = key.ID
+
+	loaded := &ringKey{
+		id:        key.ID,
+		algorithm: key.Algorithm,
+		publicKey: publicKey,
+		publicPEM: key.PublicKeyPEM,
+		jwk:       jwk,
+	}
+
+	// Only the active key signs, so other private keys are never decrypted.
+	if key.Status == entity.KeyStatusActive {
+		privatePEM, err := r.cipher.Decrypt(key.PrivateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		loaded.signer, err = parsePrivateKeyPEM([]byte(privatePEM))
+		if err != nil {
+			return nil, err
+		}
+		if !publicKeyEqual(loaded.signer.Public(), publicKey) {
+			return nil, fmt.Errorf("private key does not match public key")
+		}
+		if key.ActivatedAt != nil {
+			loaded.activatedAt = *key.ActivatedAt
+		}
+	}
+
+	return loaded, nil
+}
+
+func publicKeyEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
@@ -0,0 +1,155 @@
+package security
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memorySigningKeyRepository struct {
+	keys []*entity.SigningKey
+}
+
+func (r *memorySigningKeyRepository) Create(ctx context.Context, key *entity.SigningKey) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *memorySigningKeyRepository) FindAll(ctx context.Context) ([]*entity.SigningKey, error) {
+	return r.keys, nil
+}
+
+func (r *memorySigningKeyRepository) Rotate(ctx context.Context, activeID, nextID string, retiresAt time.Time, newNext *entity.SigningKey) error {
+	return nil
+}
+
+func (r *memorySigningKeyRepository) DeleteRetiredBefore(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+func newTestCipher(t *testing.T) *AESCipher {
+	t.Helper()
+	cipher, err := NewAESCipher(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	require.NoError(t, err)
+	return cipher
+}
+
+// writeKeyPair generates a key pair for algorithm and writes it where the
+// JWT_PRIVATE_KEY_PATH and JWT_PUBLIC_KEY_PATH settings would point.
+func writeKeyPair(t *testing.T, algorithm string) (crypto.Signer, string, string) {
+	t.Helper()
+	signer, err := generateKeyPair(algorithm)
+	require.NoError(t, err)
+	privatePEM, err := encodePrivateKeyPEM(signer)
+	require.NoError(t, err)
+	publicPEM, err := encodePublicKeyPEM(signer.Public())
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	privatePath := filepath.Join(dir, "private_key.pem")
+	publicPath := filepath.Join(dir, "public_key.pem")
+	require.NoError(t, os.WriteFile(privatePath, []byte(privatePEM), 0o600))
+	require.NoError(t, os.WriteFile(publicPath, []byte(publicPEM), 0o644))
+	return signer, privatePath, publicPath
+}
+
+var keyMismatches = []struct {
+	algorithm string
+	keyType   string
+}{
+	{algorithm: "RS256", keyType: "ES256"},
+	{algorithm: "RS256", keyType: "EdDSA"},
+	{algorithm: "ES256", keyType: "RS256"},
+	{algorithm: "ES256", keyType: "EdDSA"},
+	{algorithm: "EdDSA", keyType: "RS256"},
+	{algorithm: "EdDSA", keyType: "ES256"},
+}
+
+func TestBootstrapRejectsConfiguredKeyOfOtherType(t *testing.T) {
+	for _, tc := range keyMismatches {
+		t.Run(tc.algorithm+" with "+tc.keyType+" key", func(t *testing.T) {
+			_, privatePath, publicPath := writeKeyPair(t, tc.keyType)
+			repo := &memorySigningKeyRepository{}
+			ring := NewKeyRing(repo, newTestCipher(t), tc.algorithm, time.Hour)
+
+			require.Error(t, ring.Bootstrap(context.Background(), privatePath, publicPath))
+			require.Empty(t, repo.keys)
+		})
+	}
+}
+
+func TestBootstrapChecksConfiguredKeyWhenKeysAreStored(t *testing.T) {
+	cipher := newTestCipher(t)
+	_, privatePath, publicPath := writeKeyPair(t, "RS256")
+	repo := &memorySigningKeyRepository{}
+	require.NoError(t, NewKeyRing(repo, cipher, "RS256", time.Hour).Bootstrap(context.Background(), privatePath, publicPath))
+
+	_, otherPrivatePath, otherPublicPath := writeKeyPair(t, "EdDSA")
+	err := NewKeyRing(repo, cipher, "RS256", time.Hour).Bootstrap(context.Background(), otherPrivatePath, otherPublicPath)
+	require.Error(t, err)
+}
+
+func TestBootstrapRejectsActiveKeyOfOtherType(t *testing.T) {
+	for _, tc := range keyMismatches {
+		t.Run(tc.algorithm+" with "+tc.keyType+" key", func(t *testing.T) {
+			cipher := newTestCipher(t)
+			signer, err := generateKeyPair(tc.keyType)
+			require.NoError(t, err)
+			stored, err := NewKeyRing(nil, cipher, tc.keyType, time.Hour).toEntity(signer)
+			require.NoError(t, err)
+			stored.Algorithm = tc.algorithm
+			stored.Activate()
+
+			_, privatePath, publicPath := writeKeyPair(t, tc.algorithm)
+			repo := &memorySigningKeyRepository{keys: []*entity.SigningKey{stored}}
+			ring := NewKeyRing(repo, cipher, tc.algorithm, time.Hour)
+
+			require.Error(t, ring.Bootstrap(context.Background(), privatePath, publicPath))
+		})
+	}
+}
+
+func TestBootstrapRejectsActiveKeyWithForeignPrivateKey(t *testing.T) {
+	cipher := newTestCipher(t)
+	signer, err := generateKeyPair("ES256")
+	require.NoError(t, err)
+	stored, err := NewKeyRing(nil, cipher, "ES256", time.Hour).toEntity(signer)
+	require.NoError(t, err)
+	other, err := generateKeyPair("ES256")
+	require.NoError(t, err)
+	otherPEM, err := encodePrivateKeyPEM(other)
+	require.NoError(t, err)
+	stored.PrivateKeyPEM, err = cipher.Encrypt(otherPEM)
+	require.NoError(t, err)
+	stored.Activate()
+
+	_, privatePath, publicPath := writeKeyPair(t, "ES256")
+	repo := &memorySigningKeyRepository{keys: []*entity.SigningKey{stored}}
+	ring := NewKeyRing(repo, cipher, "ES256", time.Hour)
+
+	require.Error(t, ring.Bootstrap(context.Background(), privatePath, publicPath))
+}
+
+func TestBootstrapImportsMatchingKey(t *testing.T) {
+	for _, algorithm := range []string{"RS256", "ES256", "EdDSA"} {
+		t.Run(algorithm, func(t *testing.T) {
+			signer, privatePath, publicPath := writeKeyPair(t, algorithm)
+			repo := &memorySigningKeyRepository{}
+			ring := NewKeyRing(repo, newTestCipher(t), algorithm, time.Hour)
+
+			require.NoError(t, ring.Bootstrap(context.Background(), privatePath, publicPath))
+			active, err := ring.Active()
+			require.NoError(t, err)
+			require.Equal(t, algorithm, active.algorithm)
+			require.True(t, publicKeyEqual(signer.Public(), active.publicKey))
+		})
+	}
+}
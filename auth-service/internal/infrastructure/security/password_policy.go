@@ -0,0 +1,125 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	domainErr "auth-service/internal/domain/errors"
+)
+
+// Character classes a password policy can require.
+const (
+	CharacterClassUpper   = "upper"
+	CharacterClassLower   = "lower"
+	CharacterClassDigit   = "digit"
+	CharacterClassSpecial = "special"
+)
+
+// minEmailLocalPartLength keeps very short local parts, such as "jo", from
+// ruling out most passwords.
+const minEmailLocalPartLength = 3
+
+// PasswordPolicyRules configure PasswordPolicy. MaxRepeats is the longest run
+// of one character allowed; 0 disables the check.
+type PasswordPolicyRules struct {
+	MinLength       int
+	MaxLength       int
+	RequiredClasses []string
+	MaxRepeats      int
+	DisallowEmail   bool
+}
+
+// PasswordPolicy checks new passwords against configurable rules and, if a
+// breached-password filter is loaded, against known breached passwords.
+type PasswordPolicy struct {
+	rules    PasswordPolicyRules
+	breached *BloomFilter
+}
+
+// NewPasswordPolicy returns a policy for rules. breached may be nil to skip
+// breached-password screening.
+func NewPasswordPolicy(rules PasswordPolicyRules, breached *BloomFilter) *PasswordPolicy {
+	return &PasswordPolicy{rules: rules, breached: breached}
+}
+
+func (p *PasswordPolicy) Validate(password, email string) error {
+	var violations []domainErr.PasswordViolation
+	fail := func(rule, message string) {
+		violations = append(violations, domainErr.PasswordViolation{Rule: rule, Message: message})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.rules.MinLength {
+		fail(domainErr.PasswordRuleMinLength, fmt.Sprintf("password must be at least %d characters long", p.rules.MinLength))
+	}
+	if length > p.rules.MaxLength {
+		fail(domainErr.PasswordRuleMaxLength, fmt.Sprintf("password must not exceed %d characters", p.rules.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsNumber(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSpecial = true
+		}
+	}
+	for _, class := range p.rules.RequiredClasses {
+		switch {
+		case class == CharacterClassUpper && !hasUpper:
+			fail(domainErr.PasswordRuleUppercase, "password must contain at least one uppercase letter")
+		case class == CharacterClassLower && !hasLower:
+			fail(domainErr.PasswordRuleLowercase, "password must contain at least one lowercase letter")
+		case class == CharacterClassDigit && !hasDigit:
+			fail(domainErr.PasswordRuleDigit, "password must contain at least one number")
+		case class == CharacterClassSpecial && !hasSpecial:
+			fail(domainErr.PasswordRuleSpecial, "password must contain at least one special character")
+		}
+	}
+
+	if p.rules.MaxRepeats > 0 && longestRun(password) > p.rules.MaxRepeats {
+		fail(domainErr.PasswordRuleMaxRepeats, fmt.Sprintf("password must not repeat a character more than %d times in a row", p.rules.MaxRepeats))
+	}
+
+	if p.rules.DisallowEmail {
+		localPart, _, _ := strings.Cut(strings.ToLower(email), "@")
+		if len(localPart) >= minEmailLocalPartLength && strings.Contains(strings.ToLower(password), localPart) {
+			fail(domainErr.PasswordRuleContainsEmail, "password must not contain your email address")
+		}
+	}
+
+	// Lists are mostly lower case, so "Password1!" is caught as "password1!".
+	if p.breached != nil && (p.breached.MightContain(password) || p.breached.MightContain(strings.ToLower(password))) {
+		fail(domainErr.PasswordRuleBreached, "password has appeared in a data breach")
+	}
+
+	if len(violations) > 0 {
+		return &domainErr.PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// longestRun returns the length of the longest run of one repeated character.
+func longestRun(password string) int {
+	longest, run := 0, 0
+	var previous rune
+	for i, char := range []rune(password) {
+		if i > 0 && char == previous {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		previous = char
+	}
+	return longest
+}
@@ -0,0 +1,61 @@
+package security
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	domainErr "auth-service/internal/domain/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+// defaultPolicy is the policy the service runs with when no PASSWORD_*
+// setting is changed.
+func defaultPolicy(t *testing.T) *PasswordPolicy {
+	t.Helper()
+	breached, err := LoadBreachedPasswords("", 0.001)
+	require.NoError(t, err)
+	return NewPasswordPolicy(PasswordPolicyRules{
+		MinLength:       8,
+		MaxLength:       128,
+		RequiredClasses: []string{CharacterClassUpper, CharacterClassLower, CharacterClassDigit, CharacterClassSpecial},
+		MaxRepeats:      3,
+		DisallowEmail:   true,
+	}, breached)
+}
+
+func violatedRules(t *testing.T, err error) []string {
+	t.Helper()
+	var policyErr *domainErr.PasswordPolicyError
+	require.True(t, errors.As(err, &policyErr))
+	rules := make([]string, len(policyErr.Violations))
+	for i, v := range policyErr.Violations {
+		rules[i] = v.Rule
+	}
+	return rules
+}
+
+func TestDefaultPolicyRejectsCommonPasswords(t *testing.T) {
+	policy := defaultPolicy(t)
+
+	for _, password := range []string{"Password123!", "P@ssw0rd", "Qwerty123!", "Welcome1!", "Admin@123"} {
+		err := policy.Validate(password, "someone@example.com")
+		require.Equal(t, []string{domainErr.PasswordRuleBreached}, violatedRules(t, err), password)
+	}
+}
+
+func TestDefaultPolicyAcceptsUncommonPassword(t *testing.T) {
+	require.NoError(t, defaultPolicy(t).Validate("Tangerine-Orbit-42", "someone@example.com"))
+}
+
+func TestBreachedListFileExtendsBuiltinList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte("Tangerine-Orbit-42\r\n\nother\n"), 0o644))
+
+	breached, err := LoadBreachedPasswords(path, 0.001)
+	require.NoError(t, err)
+	require.True(t, breached.MightContain("Tangerine-Orbit-42"))
+	require.True(t, breached.MightContain("password123!"))
+}
@@ -2,104 +2,131 @@ package security
 
 import (
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/sha256"
-	"crypto/x509"
 	"encoding/base64"
-	"encoding/pem"
-	"fmt"
-	"os"
+	"errors"
 	"time"
 
+	domainErr "auth-service/internal/domain/errors"
 	"auth-service/internal/domain/service"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const tokenIssuer = "auth-service"
+
 type TokenService struct {
-	privateKey      *rsa.PrivateKey
-	publicKey       *rsa.PublicKey
-	publicKeyPEM    string
-	algorithm       string
+	keyRing         *KeyRing
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
+	idTokenIssuer   string
 }
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func NewJWTService(algorithm, privateKeyPath, publicKeyPath string, accessTokenTTL, refreshTokenTTL time.Duration) (*TokenService, error) {
-	// Load private key
-	privateKeyData, err := os.ReadFile(privateKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read private key: %w", err)
-	}
-
-	block, _ := pem.Decode(privateKeyData)
-	if block == nil {
-		return nil, fmt.Errorf("failed to decode private key PEM")
-	}
+type IDTokenClaims struct {
+	Nonce         string `json:"nonce,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	jwt.RegisteredClaims
+}
 
-	var privateKey *rsa.PrivateKey
-	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
-		privateKey = key
-	} else if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
-		privateKey = key.(*rsa.PrivateKey)
-	} else {
-		return nil, fmt.Errorf("failed to parse private key: %w", err)
+// NewJWTService creates the token service. idTokenIssuer is the OIDC issuer
+// URL put in ID tokens; access tokens keep the "auth-service" issuer that
+// every service verifies.
+func NewJWTService(keyRing *KeyRing, accessTokenTTL, refreshTokenTTL time.Duration, idTokenIssuer string) *TokenService {
+	return &TokenService{
+		keyRing:         keyRing,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		idTokenIssuer:   idTokenIssuer,
 	}
+}
 
-	// Load public key
-	publicKeyData, err := os.ReadFile(publicKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read public key: %w", err)
+func (s *TokenService) GenerateAccessToken(claims service.TokenClaims) (string, error) {
+	now := time.Now()
+	jwtClaims := Claims{
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scope:     claims.Scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
 	}
 
-	block, _ = pem.Decode(publicKeyData)
-	if block == nil {
-		return nil, fmt.Errorf("failed to decode public key PEM")
-	}
+	return s.sign(jwtClaims)
+}
 
-	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse public key: %w", err)
+// GenerateIDToken issues an ID token that lives as long as an access token.
+func (s *TokenService) GenerateIDToken(claims service.IDTokenClaims) (string, error) {
+	now := time.Now()
+	jwtClaims := IDTokenClaims{
+		Nonce: claims.Nonce,
+		Email: claims.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.idTokenIssuer,
+			Subject:   claims.Subject,
+			Audience:  jwt.ClaimStrings{claims.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
 	}
-
-	publicKey, ok := pub.(*rsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("not an RSA public key")
+	if claims.Email != "" {
+		jwtClaims.EmailVerified = &claims.EmailVerified
 	}
 
-	return &TokenService{
-		privateKey:      privateKey,
-		publicKey:       publicKey,
-		publicKeyPEM:    string(publicKeyData),
-		algorithm:       algorithm,
-		accessTokenTTL:  accessTokenTTL,
-		refreshTokenTTL: refreshTokenTTL,
-	}, nil
+	return s.sign(jwtClaims)
 }
 
-func (s *TokenService) GenerateAccessToken(claims service.TokenClaims) (string, error) {
+// GenerateServiceToken issues a machine client's access token. Its lifetime
+// must not exceed the access-token TTL, which is how long retired signing
+// keys stay published.
+func (s *TokenService) GenerateServiceToken(claims service.TokenClaims, ttl time.Duration) (string, error) {
 	now := time.Now()
 	jwtClaims := Claims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-		Role:   claims.Role,
+		Role:     claims.Role,
+		ClientID: claims.ClientID,
+		Scope:    claims.Scope,
 		RegisteredClaims: jwt.RegisteredClaims{
-			Issuer:    "auth-service",
-			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTokenTTL)),
+			Issuer:    tokenIssuer,
+			Subject:   claims.ClientID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwtClaims)
-	signedToken, err := token.SignedString(s.privateKey)
+	return s.sign(jwtClaims)
+}
+
+// sign signs claims with the active key and names it in the kid header.
+func (s *TokenService) sign(claims jwt.Claims) (string, error) {
+	key, err := s.keyRing.Active()
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethod(key.algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.id
+	signedToken, err := token.SignedString(key.signer)
 	if err != nil {
 		return "", err
 	}
@@ -119,31 +146,6 @@ func (s *TokenService) GenerateRefreshToken() (plainToken string, hashedToken st
 	return plainToken, hashedToken, nil
 }
 
-// func (s *TokenService) ExtractClaimsWithoutValidation(tokenString string) (*service.TokenClaims, error) {
-// 	// Parse without validation
-// 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, &Claims{})
-// 	if err != nil {
-// 		return nil, domainErr.ErrInvalidToken
-// 	}
-
-// 	claims, ok := token.Claims.(*Claims)
-// 	if !ok || !token.Valid {
-// 		return nil, domainErr.ErrInvalidToken
-// 	}
-
-// 	var issuedAt int64
-// 	if claims.IssuedAt != nil {
-// 		issuedAt = claims.IssuedAt.Unix()
-// 	}
-
-// 	return &service.TokenClaims{
-// 		UserID:   claims.UserID,
-// 		Email:    claims.Email,
-// 		Role:     claims.Role,
-// 		IssuedAt: issuedAt,
-// 	}, nil
-// }
-
 func (s *TokenService) HashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
 	return base64.URLEncoding.EncodeToString(hash[:])
@@ -157,39 +159,108 @@ func (s *TokenService) GetRefreshTokenExpiry() time.Duration {
 	return s.refreshTokenTTL
 }
 
+// GetPublicKey returns the PEM of the active signing key. Verifiers that
+// need to survive key rotation should use GetJWKS instead.
 func (s *TokenService) GetPublicKey() string {
-	return s.publicKeyPEM
+	key, err := s.keyRing.Active()
+	if err != nil {
+		return ""
+	}
+	return key.publicPEM
 }
 
 func (s *TokenService) GetAlgorithm() string {
-	return s.algorithm
+	key, err := s.keyRing.Active()
+	if err != nil {
+		return ""
+	}
+	return key.algorithm
 }
 
-// ExtractClaimsWithoutValidation extracts claims without signature validation
-// Used when JWT is already validated by Kong Gateway
-func (s *TokenService) ExtractClaimsWithoutValidation(tokenString string) (*service.TokenClaims, error) {
-	// Parse token without verification
-	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
-	token, _, err := parser.ParseUnverified(tokenString, &Claims{})
+func (s *TokenService) GetKeyID() string {
+	key, err := s.keyRing.Active()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
+		return ""
+	}
+	return key.id
+}
+
+func (s *TokenService) GetJWKS() []service.JSONWebKey {
+	return s.keyRing.JWKS()
+}
+
+// ValidateAccessToken verifies the signature against the published key named
+// by the kid header and checks exp, nbf and iss.
+func (s *TokenService) ValidateAccessToken(tokenString string) (*service.TokenClaims, error) {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.UserID == "" {
+		return nil, domainErr.ErrInvalidToken
+	}
+	return toTokenClaims(claims), nil
+}
+
+// ValidateServiceToken is ValidateAccessToken for the tokens of machine
+// clients, which have a client_id and no user_id.
+func (s *TokenService) ValidateServiceToken(tokenString string) (*service.TokenClaims, error) {
+	claims, err := s.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.UserID != "" || claims.ClientID == "" {
+		return nil, domainErr.ErrInvalidToken
+	}
+	return toTokenClaims(claims), nil
+}
+
+func (s *TokenService) parse(tokenString string) (*Claims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	token, err := parser.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := s.keyRing.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.algorithm {
+			return nil, domainErr.ErrInvalidToken
+		}
+		return key.publicKey, nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, domainErr.ErrTokenExpired
+		}
+		return nil, domainErr.ErrInvalidToken
 	}
 
 	claims, ok := token.Claims.(*Claims)
 	if !ok {
-		return nil, fmt.Errorf("invalid claims type")
+		return nil, domainErr.ErrInvalidToken
 	}
+	return claims, nil
+}
 
-	// Return claims even if expired (Kong already validated)
-	var issuedAt int64
+func toTokenClaims(claims *Claims) *service.TokenClaims {
+	result := &service.TokenClaims{
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scope:     claims.Scope,
+		Issuer:    claims.Issuer,
+	}
 	if claims.IssuedAt != nil {
-		issuedAt = claims.IssuedAt.Unix()
+		result.IssuedAt = claims.IssuedAt.Unix()
 	}
-
-	return &service.TokenClaims{
-		UserID:   claims.UserID,
-		Email:    claims.Email,
-		Role:     claims.Role,
-		IssuedAt: issuedAt,
-	}, nil
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	return result
 }
@@ -0,0 +1,59 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHOTPMatchesRFC4226(t *testing.T) {
+	key := []byte("12345678901234567890")
+	for counter, want := range []string{"755224", "287082", "359152", "969429", "338314"} {
+		require.Equal(t, want, hotp(key, int64(counter)))
+	}
+}
+
+func TestValidateAcceptsCodesWithinSkew(t *testing.T) {
+	s := NewTOTPService("test")
+	secret, err := s.GenerateSecret()
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+
+	// The previous step is left out: it drops out of the window if the
+	// clock crosses a step boundary during the test.
+	current := time.Now().Unix() / totpPeriod
+	for _, step := range []int64{current, current + 1} {
+		got, ok := s.Validate(secret, hotp(key, step))
+		require.True(t, ok)
+		require.Equal(t, step, got)
+	}
+}
+
+func TestValidateRejectsCodesOutsideSkew(t *testing.T) {
+	s := NewTOTPService("test")
+	secret, err := s.GenerateSecret()
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+
+	current := time.Now().Unix() / totpPeriod
+	for _, step := range []int64{current - 10, current + 10} {
+		_, ok := s.Validate(secret, hotp(key, step))
+		require.False(t, ok)
+	}
+}
+
+func TestValidateRejectsMalformedInput(t *testing.T) {
+	s := NewTOTPService("test")
+	secret, err := s.GenerateSecret()
+	require.NoError(t, err)
+
+	for _, code := range []string{"", "12345", "1234567"} {
+		_, ok := s.Validate(secret, code)
+		require.False(t, ok, code)
+	}
+	_, ok := s.Validate("not base32!", "123456")
+	require.False(t, ok)
+}
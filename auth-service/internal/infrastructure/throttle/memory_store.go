@@ -0,0 +1,132 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MemoryStore keeps throttle events in memory. Limits then apply per
+// replica; use the Postgres store when several replicas run.
+type MemoryStore struct {
+	mu     sync.Mutex
+	events map[string][]memoryEvent
+}
+
+type memoryEvent struct {
+	attemptID uuid.UUID
+	at        time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{events: make(map[string][]memoryEvent)}
+}
+
+func (s *MemoryStore) Attempt(ctx context.Context, attemptID uuid.UUID, keys []repository.ThrottleKey, at time.Time, decide func([]repository.ThrottleWindow) time.Duration) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	windows := make([]repository.ThrottleWindow, len(keys))
+	for i, k := range keys {
+		windows[i] = s.window(k.Key, k.Since)
+	}
+	if wait := decide(windows); wait > 0 {
+		return wait, nil
+	}
+
+	for _, k := range keys {
+		s.events[k.Key] = append(s.events[k.Key], memoryEvent{attemptID: attemptID, at: at})
+	}
+	return 0, nil
+}
+
+func (s *MemoryStore) window(key string, since time.Time) repository.ThrottleWindow {
+	var window repository.ThrottleWindow
+	for _, event := range s.events[key] {
+		if !event.at.After(since) {
+			continue
+		}
+		if window.Count == 0 || event.at.Before(window.First) {
+			window.First = event.at
+		}
+		if event.at.After(window.Last) {
+			window.Last = event.at
+		}
+		window.Count++
+	}
+	return window
+}
+
+func (s *MemoryStore) Forget(ctx context.Context, attemptID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, events := range s.events {
+		kept := events[:0]
+		for _, event := range events {
+			if event.attemptID != attemptID {
+				kept = append(kept, event)
+			}
+		}
+		if len(kept) == 0 {
+			delete(s.events, key)
+		} else {
+			s.events[key] = kept
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Clear(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.events, key)
+	return nil
+}
+
+func (s *MemoryStore) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, events := range s.events {
+		kept := events[:0]
+		for _, event := range events {
+			if event.at.Before(before) {
+				deleted++
+				continue
+			}
+			kept = append(kept, event)
+		}
+		if len(kept) == 0 {
+			delete(s.events, key)
+		} else {
+			s.events[key] = kept
+		}
+	}
+	return deleted, nil
+}
+
+// Run deletes events older than retention every interval until ctx is
+// done. Every replica has its own store, so this runs outside the
+// scheduler, which only runs jobs on one replica.
+func (s *MemoryStore) Run(ctx context.Context, interval, retention time.Duration, log *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if deleted, _ := s.DeleteOlderThan(ctx, time.Now().Add(-retention)); deleted > 0 {
+				log.Debug("purged throttle events", zap.Int64("deleted", deleted))
+			}
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package throttle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// Limit is a sliding-window limit on the attempts recorded under one key.
+// The first Free attempts in Window are not slowed down; each further one
+// doubles the delay, starting at BaseDelay and capped at MaxDelay, counted
+// from the previous attempt. After Max attempts the key is blocked until the
+// oldest one leaves the window, unless it is only delayed. A zero Max
+// disables the limit.
+type Limit struct {
+	Window    time.Duration
+	Free      int
+	Max       int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// wait returns how long the next attempt has to wait, given the attempts in
+// the window. Without block, attempts past Max keep the longest delay.
+func (l Limit) wait(w repository.ThrottleWindow, now time.Time, block bool) time.Duration {
+	if l.Max <= 0 || w.Count == 0 {
+		return 0
+	}
+
+	var wait time.Duration
+	switch {
+	case block && w.Count >= l.Max:
+		wait = w.First.Add(l.Window).Sub(now)
+	case w.Count >= l.Free && l.BaseDelay > 0:
+		delay := l.MaxDelay
+		// Stop doubling before the shift overflows.
+		if steps := w.Count - l.Free; steps < 32 && l.BaseDelay<<steps < l.MaxDelay {
+			delay = l.BaseDelay << steps
+		}
+		wait = w.Last.Add(delay).Sub(now)
+	}
+
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// Limits are the limits the Throttler applies. Login limits count failed
+// logins; registration limits count every registration attempt.
+// LoginPerEmail only delays logins to an account from everywhere, since
+// blocking them would let anyone lock its owner out; it blocks per IP and
+// email.
+type Limits struct {
+	LoginPerIP     Limit
+	LoginPerEmail  Limit
+	LoginGlobal    Limit
+	RegisterPerIP  Limit
+	RegisterGlobal Limit
+}
+
+const (
+	loginIPPrefix      = "login:ip:"
+	loginEmailPrefix   = "login:email:"
+	loginIPEmailPrefix = "login:ip-email:"
+	loginGlobalKey     = "login:global"
+	registerIPPrefix   = "register:ip:"
+	registerGlobal     = "register:global"
+)
+
+// Throttler slows down and then blocks repeated logins and registrations,
+// in layers: per client IP against one address guessing many passwords, per
+// email against many addresses guessing one account's password, which is
+// only slowed down, and globally against distributed attacks. The per-account lock in
+// User.IncrementFailedLoginAttempts still applies on top.
+type Throttler struct {
+	store  repository.ThrottleRepository
+	limits Limits
+}
+
+func NewThrottler(store repository.ThrottleRepository, limits Limits) *Throttler {
+	return &Throttler{store: store, limits: limits}
+}
+
+// Retention is the longest window of any limit; older events no longer
+// count and can be deleted.
+func (t *Throttler) Retention() time.Duration {
+	var retention time.Duration
+	for _, l := range []Limit{t.limits.LoginPerIP, t.limits.LoginPerEmail, t.limits.LoginGlobal, t.limits.RegisterPerIP, t.limits.RegisterGlobal} {
+		if l.Window > retention {
+			retention = l.Window
+		}
+	}
+	return retention
+}
+
+// AttemptLogin returns how long a login from ip for email has to wait; zero
+// means it may go ahead. A login that goes ahead counts as failed from the
+// start, under the returned attempt ID, so that concurrent guesses count
+// each other; ForgetLogin and LoginSucceeded take it back. An empty ip skips
+// the per-IP limits.
+func (t *Throttler) AttemptLogin(ctx context.Context, ip, email string) (uuid.UUID, time.Duration, error) {
+	return t.attempt(ctx, t.loginKeys(ip, email))
+}
+
+// ForgetLogin stops counting a login that did not fail on its credentials.
+func (t *Throttler) ForgetLogin(ctx context.Context, attemptID uuid.UUID) error {
+	return t.store.Forget(ctx, attemptID)
+}
+
+// LoginSucceeded forgets the attempt and the failed logins of the account.
+// Those of the IP are kept, as one address may be trying many accounts.
+func (t *Throttler) LoginSucceeded(ctx context.Context, attemptID uuid.UUID, ip, email string) error {
+	if err := t.store.Forget(ctx, attemptID); err != nil {
+		return err
+	}
+	if err := t.store.Clear(ctx, loginEmailPrefix+emailKey(email)); err != nil {
+		return err
+	}
+	return t.store.Clear(ctx, loginIPEmailPrefix+ip+":"+emailKey(email))
+}
+
+// AttemptRegister returns how long a registration from ip has to wait; zero
+// means it may go ahead, and it is counted.
+func (t *Throttler) AttemptRegister(ctx context.Context, ip string) (time.Duration, error) {
+	_, wait, err := t.attempt(ctx, t.registerKeys(ip))
+	return wait, err
+}
+
+type keyedLimit struct {
+	key   string
+	limit Limit
+	block bool
+}
+
+func (t *Throttler) loginKeys(ip, email string) []keyedLimit {
+	keys := []keyedLimit{
+		{loginEmailPrefix + emailKey(email), t.limits.LoginPerEmail, false},
+		{loginGlobalKey, t.limits.LoginGlobal, true},
+	}
+	if ip != "" {
+		keys = append(keys,
+			keyedLimit{loginIPPrefix + ip, t.limits.LoginPerIP, true},
+			keyedLimit{loginIPEmailPrefix + ip + ":" + emailKey(email), t.limits.LoginPerEmail, true},
+		)
+	}
+	return keys
+}
+
+func (t *Throttler) registerKeys(ip string) []keyedLimit {
+	keys := []keyedLimit{{registerGlobal, t.limits.RegisterGlobal, true}}
+	if ip != "" {
+		keys = append(keys, keyedLimit{registerIPPrefix + ip, t.limits.RegisterPerIP, true})
+	}
+	return keys
+}
+
+// attempt records an attempt under every enabled limit of keys, unless one
+// of them makes it wait.
+func (t *Throttler) attempt(ctx context.Context, keys []keyedLimit) (uuid.UUID, time.Duration, error) {
+	now := time.Now()
+
+	var enabled []keyedLimit
+	var windows []repository.ThrottleKey
+	for _, k := range keys {
+		if k.limit.Max <= 0 {
+			continue
+		}
+		enabled = append(enabled, k)
+		windows = append(windows, repository.ThrottleKey{Key: k.key, Since: now.Add(-k.limit.Window)})
+	}
+
+	attemptID := uuid.New()
+	wait, err := t.store.Attempt(ctx, attemptID, windows, now, func(counted []repository.ThrottleWindow) time.Duration {
+		var longest time.Duration
+		for i, k := range enabled {
+			if wait := k.limit.wait(counted[i], now, k.block); wait > longest {
+				longest = wait
+			}
+		}
+		return longest
+	})
+	return attemptID, wait, err
+}
+
+// emailKey hashes the normalised address so the throttle store does not hold
+// the addresses themselves.
+func emailKey(email string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,93 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailLimitDoesNotBlockOtherAddresses(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewThrottler(NewMemoryStore(), Limits{
+		LoginPerEmail: Limit{Window: time.Hour, Free: 3, Max: 3},
+	})
+
+	for i := 0; i < 3; i++ {
+		_, wait, err := throttler.AttemptLogin(ctx, "198.51.100.1", "victim@example.com")
+		require.NoError(t, err)
+		require.Zero(t, wait)
+	}
+
+	// The guessing address is blocked...
+	_, wait, err := throttler.AttemptLogin(ctx, "198.51.100.1", "victim@example.com")
+	require.NoError(t, err)
+	require.Positive(t, wait)
+
+	// ...but the owner, elsewhere, is not.
+	_, wait, err = throttler.AttemptLogin(ctx, "203.0.113.7", "victim@example.com")
+	require.NoError(t, err)
+	require.Zero(t, wait)
+}
+
+func TestEmailLimitDelaysPastMax(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewThrottler(NewMemoryStore(), Limits{
+		LoginPerEmail: Limit{Window: time.Hour, Free: 1, Max: 2, BaseDelay: time.Second, MaxDelay: time.Minute},
+	})
+
+	_, wait, err := throttler.AttemptLogin(ctx, "198.51.100.1", "victim@example.com")
+	require.NoError(t, err)
+	require.Zero(t, wait)
+
+	_, wait, err = throttler.AttemptLogin(ctx, "203.0.113.7", "victim@example.com")
+	require.NoError(t, err)
+	require.Positive(t, wait)
+	require.LessOrEqual(t, wait, time.Second)
+}
+
+func TestConcurrentLoginsCountEachOther(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewThrottler(NewMemoryStore(), Limits{
+		LoginPerIP: Limit{Window: time.Hour, Free: 5, Max: 5},
+	})
+
+	const attempts = 20
+	var allowed int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, wait, err := throttler.AttemptLogin(ctx, "198.51.100.1", "user@example.com")
+			require.NoError(t, err)
+			if wait == 0 {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 5, allowed)
+}
+
+func TestForgottenLoginsDoNotCount(t *testing.T) {
+	ctx := context.Background()
+	throttler := NewThrottler(NewMemoryStore(), Limits{
+		LoginPerIP: Limit{Window: time.Hour, Free: 1, Max: 1},
+	})
+
+	attemptID, wait, err := throttler.AttemptLogin(ctx, "198.51.100.1", "user@example.com")
+	require.NoError(t, err)
+	require.Zero(t, wait)
+	require.NoError(t, throttler.ForgetLogin(ctx, attemptID))
+
+	_, wait, err = throttler.AttemptLogin(ctx, "198.51.100.1", "user@example.com")
+	require.NoError(t, err)
+	require.Zero(t, wait)
+}
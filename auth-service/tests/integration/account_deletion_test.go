@@ -0,0 +1,51 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	email := "delete_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	password := "StrongPass123!"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	login, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+
+	_, err = client.DeleteAccount(authed, &pb.DeleteAccountRequest{Password: "WrongPass123!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.DeleteAccount(authed, &pb.DeleteAccountRequest{Password: password})
+	require.NoError(t, err)
+
+	// Every session ends with the account.
+	_, err = client.GetMe(authed, &pb.GetMeRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = refresh(client, login.AccessToken, login.RefreshToken)
+	require.Error(t, err)
+
+	_, err = client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The email address is free again.
+	_, err = client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+	me, err := client.GetMe(authed, &pb.GetMeRequest{})
+	require.NoError(t, err)
+
+	created, err := client.CreateAPIKey(authed, &pb.CreateAPIKeyRequest{
+		Name:   "integration",
+		Scopes: []string{"orders.read"},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Key, created.ApiKey.Prefix))
+	require.NotEmpty(t, created.ApiKey.ExpiresAt)
+
+	ctx, cancel := serviceContext(t, client)
+	defer cancel()
+
+	introspected, err := client.IntrospectAPIKey(ctx, &pb.IntrospectAPIKeyRequest{Key: created.Key})
+	require.NoError(t, err)
+	require.True(t, introspected.Active)
+	require.Equal(t, created.ApiKey.Id, introspected.KeyId)
+	require.Equal(t, me.Id, introspected.Sub)
+	require.Equal(t, []string{"orders.read"}, introspected.Scopes)
+
+	// The plain key is never listed, but the last use is.
+	listed, err := client.ListAPIKeys(authed, &pb.ListAPIKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.ApiKeys, 1)
+	require.Equal(t, created.ApiKey.Id, listed.ApiKeys[0].Id)
+	require.NotEmpty(t, listed.ApiKeys[0].LastUsedAt)
+
+	_, err = client.RevokeAPIKey(authed, &pb.RevokeAPIKeyRequest{KeyId: created.ApiKey.Id})
+	require.NoError(t, err)
+
+	introspected, err = client.IntrospectAPIKey(ctx, &pb.IntrospectAPIKeyRequest{Key: created.Key})
+	require.NoError(t, err)
+	require.False(t, introspected.Active)
+	require.Empty(t, introspected.Sub)
+
+	_, err = client.RevokeAPIKey(authed, &pb.RevokeAPIKeyRequest{KeyId: created.ApiKey.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateAPIKeyRejectsUnknownScope(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	authed, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.CreateAPIKey(authed, &pb.CreateAPIKeyRequest{
+		Name:   "integration",
+		Scopes: []string{"users.read"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRevokeAPIKeyOfAnotherUser(t *testing.T) {
+	client := newTestClient(t)
+	owner := registerAndLogin(t, client)
+	other := registerAndLogin(t, client)
+
+	ownerCtx, cancelOwner := authedContext(owner.AccessToken)
+	defer cancelOwner()
+	created, err := client.CreateAPIKey(ownerCtx, &pb.CreateAPIKeyRequest{
+		Name:   "integration",
+		Scopes: []string{"profile.read"},
+	})
+	require.NoError(t, err)
+
+	otherCtx, cancelOther := authedContext(other.AccessToken)
+	defer cancelOther()
+	_, err = client.RevokeAPIKey(otherCtx, &pb.RevokeAPIKeyRequest{KeyId: created.ApiKey.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
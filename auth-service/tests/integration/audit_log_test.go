@@ -0,0 +1,42 @@
+//go:build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestGetMyActivityShowsLogin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	activity, err := client.GetMyActivity(ctx, &pb.GetMyActivityRequest{Limit: 10})
+	require.NoError(t, err)
+	require.NotEmpty(t, activity.Events)
+	require.Equal(t, "login", activity.Events[0].Action)
+}
+
+func TestSearchAuditLogsRequiresAdmin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.SearchAuditLogs(ctx, &pb.SearchAuditLogsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	stream, err := client.StreamAuditLogs(ctx, &pb.SearchAuditLogsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
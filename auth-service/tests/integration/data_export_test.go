@@ -0,0 +1,43 @@
+//go:build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestExportMyData(t *testing.T) {
+	client := newTestClient(t)
+	owner := registerAndLogin(t, client)
+	other := registerAndLogin(t, client)
+
+	ownerCtx, cancelOwner := authedContext(owner.AccessToken)
+	defer cancelOwner()
+
+	requested, err := client.ExportMyData(ownerCtx, &pb.ExportMyDataRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, requested.Export.Id)
+	require.NotEmpty(t, requested.Export.CreatedAt)
+
+	fetched, err := client.GetDataExport(ownerCtx, &pb.GetDataExportRequest{ExportId: requested.Export.Id})
+	require.NoError(t, err)
+	require.Equal(t, requested.Export.Id, fetched.Export.Id)
+	require.Contains(t, []string{"pending", "ready", "failed"}, fetched.Export.Status)
+
+	_, err = client.GetDataExport(ownerCtx, &pb.GetDataExportRequest{ExportId: "not-a-uuid"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Another user cannot see or download the export.
+	otherCtx, cancelOther := authedContext(other.AccessToken)
+	defer cancelOther()
+	_, err = client.GetDataExport(otherCtx, &pb.GetDataExportRequest{ExportId: requested.Export.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.DownloadDataExport(otherCtx, &pb.DownloadDataExportRequest{ExportId: requested.Export.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,55 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestRequestEmailChange(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	suffix := time.Now().Format("20060102150405.000000")
+	email := "change_" + suffix + "@example.com"
+	taken := "taken_" + suffix + "@example.com"
+	password := "StrongPass123!"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	_, err = client.Register(ctx, &pb.RegisterRequest{Email: taken, Password: password})
+	require.NoError(t, err)
+	login, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+
+	newEmail := "changed_" + suffix + "@example.com"
+	_, err = client.RequestEmailChange(authed, &pb.RequestEmailChangeRequest{NewEmail: newEmail, Password: "WrongPass123!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.RequestEmailChange(authed, &pb.RequestEmailChangeRequest{NewEmail: email, Password: password})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.RequestEmailChange(authed, &pb.RequestEmailChangeRequest{NewEmail: taken, Password: password})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.RequestEmailChange(authed, &pb.RequestEmailChangeRequest{NewEmail: newEmail, Password: password})
+	require.NoError(t, err)
+
+	// Nothing changes until the link mailed to the new address is opened.
+	me, err := client.GetMe(authed, &pb.GetMeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, email, me.GetEmail())
+
+	_, err = client.ConfirmEmailChange(ctx, &pb.ConfirmEmailChangeRequest{Token: "not-a-token"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
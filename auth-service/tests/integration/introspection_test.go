@@ -0,0 +1,105 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// serviceContext calls as a machine client with the tokens.introspect scope,
+// the way the user and order services call the introspection RPCs. Its
+// credentials come from SERVICE_CLIENT_ID and SERVICE_CLIENT_SECRET, which
+// must name a client in the server's OAUTH_MACHINE_CLIENTS.
+func serviceContext(t *testing.T, client pb.AuthServiceClient) (context.Context, context.CancelFunc) {
+	t.Helper()
+	clientID, secret := os.Getenv("SERVICE_CLIENT_ID"), os.Getenv("SERVICE_CLIENT_SECRET")
+	if clientID == "" || secret == "" {
+		t.Skip("Skipping integration test: SERVICE_CLIENT_ID and SERVICE_CLIENT_SECRET are not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	token, err := client.OAuthToken(ctx, &pb.OAuthTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientID,
+		ClientSecret: secret,
+		Scope:        "tokens.introspect",
+	})
+	if err != nil {
+		cancel()
+		require.NoError(t, err)
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token.AccessToken)), cancel
+}
+
+func TestIntrospectionRequiresServiceToken(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := client.IntrospectToken(ctx, &pb.IntrospectTokenRequest{Token: login.AccessToken})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Nor does a user's own token.
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+	_, err = client.IntrospectToken(authed, &pb.IntrospectTokenRequest{Token: login.AccessToken})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.ListRevokedTokens(authed, &pb.ListRevokedTokensRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.IntrospectAPIKey(authed, &pb.IntrospectAPIKeyRequest{Key: "ak_invalid"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestIntrospectTokenReportsClaimsUntilLogout(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+	me, err := client.GetMe(authed, &pb.GetMeRequest{})
+	require.NoError(t, err)
+
+	ctx, cancel := serviceContext(t, client)
+	defer cancel()
+
+	resp, err := client.IntrospectToken(ctx, &pb.IntrospectTokenRequest{Token: login.AccessToken})
+	require.NoError(t, err)
+	require.True(t, resp.Active)
+	require.Equal(t, me.Id, resp.Sub)
+	require.Equal(t, me.Email, resp.Email)
+	require.Equal(t, "auth-service", resp.Iss)
+	require.Greater(t, resp.Exp, time.Now().Unix())
+
+	_, err = client.Logout(authed, &pb.LogoutRequest{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+
+	resp, err = client.IntrospectToken(ctx, &pb.IntrospectTokenRequest{Token: login.AccessToken})
+	require.NoError(t, err)
+	require.False(t, resp.Active)
+	require.Empty(t, resp.Sub)
+}
+
+func TestIntrospectTokenRejectsForgedToken(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := serviceContext(t, client)
+	defer cancel()
+
+	// alg "none" with a user_id claim; it must not be accepted as signed.
+	forged := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJ1c2VyX2lkIjoiMDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAwIiwiaXNzIjoiYXV0aC1zZXJ2aWNlIiwiZXhwIjo0MTAyNDQ0ODAwfQ."
+	resp, err := client.IntrospectToken(ctx, &pb.IntrospectTokenRequest{Token: forged})
+	require.NoError(t, err)
+	require.False(t, resp.Active)
+}
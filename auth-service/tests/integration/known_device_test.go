@@ -0,0 +1,64 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestLoginFromNewDeviceIsAudited(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	email := "device_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	password := "StrongPass123!"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	// The first login has nothing to compare with and is not reported.
+	_, err = client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	// newTestClient picks another address, and the user agent is new too.
+	otherDevice := newTestClient(t)
+	otherCtx := metadata.AppendToOutgoingContext(ctx, "user-agent", "integration-test-new-device")
+	login, err := otherDevice.Login(otherCtx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.AccessToken)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+	activity, err := client.GetMyActivity(authed, &pb.GetMyActivityRequest{Limit: 10})
+	require.NoError(t, err)
+
+	var actions []string
+	for _, event := range activity.Events {
+		actions = append(actions, event.Action)
+	}
+	require.Contains(t, actions, "new_device_login")
+}
+
+func TestReportUnrecognizedLoginRejectsUnknownToken(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ReportUnrecognizedLogin(ctx, &pb.ReportUnrecognizedLoginRequest{
+		Token:     "unknown",
+		SessionId: uuid.NewString(),
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,135 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// totpCode computes the RFC 6238 code an authenticator app shows for secret
+// at the given 30-second step.
+func totpCode(t *testing.T, secret string, step int64) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+func currentTOTPStep() int64 {
+	return time.Now().Unix() / 30
+}
+
+type mfaAccount struct {
+	email         string
+	secret        string
+	enrolledStep  int64
+	recoveryCodes []string
+}
+
+// registerWithMFA creates an account and enables MFA with a code for the
+// current step.
+func registerWithMFA(t *testing.T, client pb.AuthServiceClient) mfaAccount {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	email := "mfa_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: "StrongPass123!"})
+	require.NoError(t, err)
+	login, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: "StrongPass123!"})
+	require.NoError(t, err)
+
+	authed, authedCancel := authedContext(login.AccessToken)
+	defer authedCancel()
+	enrollment, err := client.EnrollMFA(authed, &pb.EnrollMFARequest{})
+	require.NoError(t, err)
+	step := currentTOTPStep()
+	confirmed, err := client.ConfirmMFA(authed, &pb.ConfirmMFARequest{Code: totpCode(t, enrollment.Secret, step)})
+	require.NoError(t, err)
+	require.NotEmpty(t, confirmed.RecoveryCodes)
+
+	return mfaAccount{email: email, secret: enrollment.Secret, enrolledStep: step, recoveryCodes: confirmed.RecoveryCodes}
+}
+
+// verifyMFA logs in with the password and answers the MFA challenge with code.
+func verifyMFA(t *testing.T, client pb.AuthServiceClient, email, code string) (*pb.LoginResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	challenge, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: "StrongPass123!"})
+	require.NoError(t, err)
+	require.True(t, challenge.MfaRequired)
+	require.Empty(t, challenge.AccessToken)
+
+	return client.VerifyMFA(ctx, &pb.VerifyMFARequest{MfaToken: challenge.MfaToken, Code: code})
+}
+
+func TestVerifyMFAAcceptsTOTPCode(t *testing.T) {
+	client := newTestClient(t)
+	account := registerWithMFA(t, client)
+
+	// Enrollment consumed its step; the next one is still inside the
+	// accepted window.
+	resp, err := verifyMFA(t, client, account.email, totpCode(t, account.secret, account.enrolledStep+1))
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.AccessToken)
+}
+
+func TestVerifyMFARejectsWrongCode(t *testing.T) {
+	client := newTestClient(t)
+	account := registerWithMFA(t, client)
+
+	_, err := verifyMFA(t, client, account.email, totpCode(t, account.secret, account.enrolledStep+10))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestVerifyMFARejectsReplayedStep(t *testing.T) {
+	client := newTestClient(t)
+	account := registerWithMFA(t, client)
+
+	// The code used to confirm enrollment cannot log in.
+	_, err := verifyMFA(t, client, account.email, totpCode(t, account.secret, account.enrolledStep))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	code := totpCode(t, account.secret, account.enrolledStep+1)
+	_, err = verifyMFA(t, client, account.email, code)
+	require.NoError(t, err)
+
+	_, err = verifyMFA(t, client, account.email, code)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRecoveryCodeWorksOnce(t *testing.T) {
+	client := newTestClient(t)
+	account := registerWithMFA(t, client)
+
+	resp, err := verifyMFA(t, client, account.email, account.recoveryCodes[0])
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.AccessToken)
+
+	_, err = verifyMFA(t, client, account.email, account.recoveryCodes[0])
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = verifyMFA(t, client, account.email, account.recoveryCodes[1])
+	require.NoError(t, err)
+}
@@ -0,0 +1,120 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestOpenIDConfigurationRequiresPKCE(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	config, err := client.GetOpenIDConfiguration(ctx, &pb.GetOpenIDConfigurationRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, config.Issuer)
+	require.Equal(t, []string{"S256"}, config.CodeChallengeMethodsSupported)
+	require.Equal(t, []string{"code"}, config.ResponseTypesSupported)
+	require.Contains(t, config.GrantTypesSupported, "authorization_code")
+	require.Contains(t, config.GrantTypesSupported, "refresh_token")
+	require.Contains(t, config.GrantTypesSupported, "client_credentials")
+	require.Contains(t, config.ScopesSupported, "openid")
+}
+
+func TestOAuthTokenRejectsUnknownClient(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.OAuthToken(ctx, &pb.OAuthTokenRequest{
+		GrantType:    "authorization_code",
+		Code:         "not-a-code",
+		ClientId:     uuid.NewString(),
+		CodeVerifier: "verifier",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.OAuthToken(ctx, &pb.OAuthTokenRequest{GrantType: "password"})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClientCredentialsRejectsUnknownClient(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.OAuthToken(ctx, &pb.OAuthTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     uuid.NewString(),
+		ClientSecret: "not-a-secret",
+		Scope:        "users.read",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorizeRejectsUnknownClient(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	// Unknown clients are reported to the login app instead of redirecting.
+	_, err := client.Authorize(ctx, &pb.AuthorizeRequest{
+		ResponseType:        "code",
+		ClientId:            uuid.NewString(),
+		RedirectUri:         "https://client.example/callback",
+		Scope:               "openid",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateOAuthClientRequiresAdmin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.CreateOAuthClient(ctx, &pb.CreateOAuthClientRequest{
+		Name:         "Test SPA",
+		RedirectUris: []string{"http://localhost:5173/callback"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCreateMachineClientRequiresAdmin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.CreateOAuthClient(ctx, &pb.CreateOAuthClientRequest{
+		Name:    "order-service",
+		Machine: true,
+		Scopes:  []string{"users.read"},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
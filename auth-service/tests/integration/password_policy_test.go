@@ -0,0 +1,126 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// violatedRules returns the reasons of the BadRequest detail of err.
+func violatedRules(t *testing.T, err error) []string {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	var rules []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				require.Equal(t, "password", v.Field)
+				rules = append(rules, v.Reason)
+			}
+		}
+	}
+	return rules
+}
+
+func TestRegisterReportsEveryBrokenPasswordRule(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	email := "policy_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: "aaaa"})
+	require.Error(t, err)
+	require.ElementsMatch(t,
+		[]string{"min_length", "uppercase", "digit", "special", "max_repeats"},
+		violatedRules(t, err),
+	)
+}
+
+func TestRegisterRejectsPasswordContainingEmail(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	localPart := "policy" + time.Now().Format("150405")
+	_, err := client.Register(ctx, &pb.RegisterRequest{
+		Email:    localPart + "@example.com",
+		Password: "X1!" + localPart,
+	})
+	require.Error(t, err)
+	require.Equal(t, []string{"contains_email"}, violatedRules(t, err))
+}
+
+func TestRegisterRejectsCommonPassword(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	email := "policy_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: "Password123!"})
+	require.Error(t, err)
+	require.Equal(t, []string{"breached"}, violatedRules(t, err))
+}
+
+func TestChangePasswordRejectsRecentPasswords(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	authed, cancelAuthed := authedContext(login.AccessToken)
+	defer cancelAuthed()
+	me, err := client.GetMe(authed, &pb.GetMeRequest{})
+	require.NoError(t, err)
+
+	// The current password is rejected.
+	_, err = client.ChangePassword(authed, &pb.ChangePasswordRequest{
+		OldPassword: "StrongPass123!",
+		NewPassword: "StrongPass123!",
+	})
+	require.Equal(t, []string{"reused"}, violatedRules(t, err))
+
+	_, err = client.ChangePassword(authed, &pb.ChangePasswordRequest{
+		OldPassword: "StrongPass123!",
+		NewPassword: "OtherPass456?",
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	relogin, err := client.Login(ctx, &pb.LoginRequest{Email: me.Email, Password: "OtherPass456?"})
+	require.NoError(t, err)
+
+	// So is the one it replaced.
+	reauthed, cancelReauthed := authedContext(relogin.AccessToken)
+	defer cancelReauthed()
+	_, err = client.ChangePassword(reauthed, &pb.ChangePasswordRequest{
+		OldPassword: "OtherPass456?",
+		NewPassword: "StrongPass123!",
+	})
+	require.Equal(t, []string{"reused"}, violatedRules(t, err))
+}
+
+func TestChangeExpiredPasswordRejectsUnknownToken(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ChangeExpiredPassword(ctx, &pb.ChangeExpiredPasswordRequest{
+		PasswordChangeToken: "not-a-token",
+		NewPassword:         "OtherPass456?",
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
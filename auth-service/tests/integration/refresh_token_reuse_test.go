@@ -0,0 +1,149 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// reuseGracePeriod must match REFRESH_TOKEN_REUSE_GRACE of the server under test.
+func reuseGracePeriod(t *testing.T) time.Duration {
+	grace := 5 * time.Second
+	if v := os.Getenv("REFRESH_TOKEN_REUSE_GRACE"); v != "" {
+		d, err := time.ParseDuration(v)
+		require.NoError(t, err)
+		grace = d
+	}
+	return grace
+}
+
+func newTestClient(t *testing.T) pb.AuthServiceClient {
+	// Each client gets its own address so the per-IP throttle of one test
+	// does not spill into the next; see forwardedFor.
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(forwardedFor(newTestClientIP())),
+	)
+	if err != nil {
+		t.Skip("Skipping integration test: server not reachable")
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	client := pb.NewAuthServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := client.HealthCheck(ctx, &pb.HealthCheckRequest{}); err != nil {
+		t.Skipf("Skipping integration test: server not reachable: %v", err)
+	}
+	return client
+}
+
+func registerAndLogin(t *testing.T, client pb.AuthServiceClient) *pb.LoginResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	email := "reuse_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	password := "StrongPass123!"
+
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	loginResp, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	require.NotEmpty(t, loginResp.RefreshToken)
+	return loginResp
+}
+
+// authedContext mimics a request forwarded by Kong with a bearer token.
+func authedContext(accessToken string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	md := metadata.Pairs(
+		"x-consumer-id", "integration-test",
+		"authorization", "Bearer "+accessToken,
+		"user-agent", "integration-test",
+	)
+	return metadata.NewOutgoingContext(ctx, md), cancel
+}
+
+func refresh(client pb.AuthServiceClient, accessToken, refreshToken string) (*pb.RefreshTokenResponse, error) {
+	ctx, cancel := authedContext(accessToken)
+	defer cancel()
+	return client.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: refreshToken})
+}
+
+// refreshWithoutAccessToken refreshes as an attacker who stole only the
+// refresh token.
+func refreshWithoutAccessToken(client pb.AuthServiceClient, refreshToken string) (*pb.RefreshTokenResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return client.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: refreshToken})
+}
+
+func TestRefreshTokenReplayRevokesFamily(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	// Legitimate client rotates its token.
+	rotated, err := refresh(client, login.AccessToken, login.RefreshToken)
+	require.NoError(t, err)
+
+	// Attacker replays the stolen, already rotated token after the grace period.
+	time.Sleep(reuseGracePeriod(t) + time.Second)
+	_, err = refreshWithoutAccessToken(client, login.RefreshToken)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "refresh token reuse detected", status.Convert(err).Message())
+
+	// The legitimate client's current token belongs to the same family and
+	// must have been revoked as well.
+	_, err = refresh(client, rotated.AccessToken, rotated.RefreshToken)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "invalid token", status.Convert(err).Message())
+}
+
+func TestRefreshTokenReplayWithRevokedAccessToken(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	_, err := refresh(client, login.AccessToken, login.RefreshToken)
+	require.NoError(t, err)
+
+	// The access token was blacklisted by the rotation, but the replay must
+	// still reach reuse detection rather than be turned away up front.
+	time.Sleep(reuseGracePeriod(t) + time.Second)
+	_, err = refresh(client, login.AccessToken, login.RefreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "refresh token reuse detected", status.Convert(err).Message())
+}
+
+func TestRefreshTokenConcurrentReuseWithinGracePeriod(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	rotated, err := refresh(client, login.AccessToken, login.RefreshToken)
+	require.NoError(t, err)
+
+	// A second tab refreshing with the same token right away is rejected by
+	// the grace logic...
+	_, err = refresh(client, login.AccessToken, login.RefreshToken)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "token revoked", status.Convert(err).Message())
+
+	// ...but is not treated as theft, so the family stays usable.
+	_, err = refresh(client, rotated.AccessToken, rotated.RefreshToken)
+	require.NoError(t, err)
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package integration
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestLoggedOutAccessTokenIsRejected(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.GetMe(ctx, &pb.GetMeRequest{})
+	require.NoError(t, err)
+
+	_, err = client.Logout(ctx, &pb.LogoutRequest{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+
+	_, err = client.GetMe(ctx, &pb.GetMeRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRevocationFeedContainsLoggedOutToken(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.Logout(ctx, &pb.LogoutRequest{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+
+	serviceCtx, cancelService := serviceContext(t, client)
+	defer cancelService()
+	resp, err := client.ListRevokedTokens(serviceCtx, &pb.ListRevokedTokensRequest{
+		SinceUnixMs: time.Now().Add(-time.Minute).UnixMilli(),
+	})
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(login.AccessToken))
+	hash := base64.URLEncoding.EncodeToString(sum[:])
+	found := false
+	for _, token := range resp.Tokens {
+		if token.TokenHash == hash {
+			found = true
+		}
+	}
+	require.True(t, found)
+	require.NotZero(t, resp.NextSinceUnixMs)
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// loginTwice registers a user and logs in from two devices.
+func loginTwice(t *testing.T, client pb.AuthServiceClient) (*pb.LoginResponse, *pb.LoginResponse) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	email := "sessions_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	password := "StrongPass123!"
+
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: password})
+	require.NoError(t, err)
+
+	first, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	second, err := client.Login(ctx, &pb.LoginRequest{Email: email, Password: password})
+	require.NoError(t, err)
+	return first, second
+}
+
+func TestListAndRevokeSession(t *testing.T) {
+	client := newTestClient(t)
+	first, second := loginTwice(t, client)
+
+	ctx, cancel := authedContext(first.AccessToken)
+	defer cancel()
+
+	list, err := client.ListSessions(ctx, &pb.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 2)
+
+	var other string
+	for _, session := range list.Sessions {
+		if !session.Current {
+			other = session.Id
+		}
+	}
+	require.NotEmpty(t, other)
+
+	_, err = client.RevokeSession(ctx, &pb.RevokeSessionRequest{SessionId: other})
+	require.NoError(t, err)
+
+	_, err = refresh(client, second.AccessToken, second.RefreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = refresh(client, first.AccessToken, first.RefreshToken)
+	require.NoError(t, err)
+}
+
+func TestLogoutOtherDevicesKeepsCurrentSession(t *testing.T) {
+	client := newTestClient(t)
+	first, second := loginTwice(t, client)
+
+	ctx, cancel := authedContext(first.AccessToken)
+	defer cancel()
+
+	_, err := client.LogoutAll(ctx, &pb.LogoutAllRequest{KeepCurrentSession: true})
+	require.NoError(t, err)
+
+	_, err = refresh(client, second.AccessToken, second.RefreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = refresh(client, first.AccessToken, first.RefreshToken)
+	require.NoError(t, err)
+}
+
+func TestRevokeSessionOfAnotherUser(t *testing.T) {
+	client := newTestClient(t)
+	victim, _ := loginTwice(t, client)
+	attacker := registerAndLogin(t, client)
+
+	victimCtx, cancel := authedContext(victim.AccessToken)
+	defer cancel()
+	list, err := client.ListSessions(victimCtx, &pb.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, list.Sessions)
+
+	attackerCtx, cancel := authedContext(attacker.AccessToken)
+	defer cancel()
+	_, err = client.RevokeSession(attackerCtx, &pb.RevokeSessionRequest{SessionId: list.Sessions[0].Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
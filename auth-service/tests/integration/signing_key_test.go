@@ -0,0 +1,56 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestAccessTokenKeyIsPublishedInJWKS(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	token, _, err := jwt.NewParser().ParseUnverified(login.AccessToken, jwt.MapClaims{})
+	require.NoError(t, err)
+	kid, _ := token.Header["kid"].(string)
+	require.NotEmpty(t, kid)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	jwks, err := client.GetJWKS(ctx, &pb.GetJWKSRequest{})
+	require.NoError(t, err)
+
+	var kids []string
+	for _, key := range jwks.Keys {
+		kids = append(kids, key.Kid)
+	}
+	require.Contains(t, kids, kid)
+	// The next key is published ahead of its activation.
+	require.GreaterOrEqual(t, len(kids), 2)
+
+	publicKey, err := client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, kid, publicKey.KeyId)
+}
+
+func TestRotateSigningKeysRequiresAdmin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.RotateSigningKeys(ctx, &pb.RotateSigningKeysRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,76 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// newTestClientIP returns a random address in 198.18.0.0/15, the range
+// reserved for benchmarking.
+func newTestClientIP() string {
+	return fmt.Sprintf("198.%d.%d.%d", 18+rand.Intn(2), rand.Intn(256), 1+rand.Intn(254))
+}
+
+// forwardedFor sends every call as if Kong forwarded it from ip. The server
+// only believes the header because TRUSTED_PROXIES lists the test runner;
+// otherwise every test shares the runner's address and its throttle limits.
+func forwardedFor(ip string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", ip)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func TestLoginIsThrottledAfterRepeatedFailures(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	email := "throttle_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	_, err := client.Register(ctx, &pb.RegisterRequest{Email: email, Password: "StrongPass123!"})
+	require.NoError(t, err)
+
+	// Failures are free up to THROTTLE_LOGIN_EMAIL_FREE, then delayed.
+	var header metadata.MD
+	for attempt := 0; ; attempt++ {
+		require.Less(t, attempt, 10, "login was never throttled")
+
+		_, err = client.Login(ctx, &pb.LoginRequest{Email: email, Password: "WrongPass123!"}, grpc.Header(&header))
+		if status.Code(err) == codes.ResourceExhausted {
+			break
+		}
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	st, _ := status.FromError(err)
+
+	require.Len(t, header.Get("retry-after"), 1)
+	retryAfter, err := strconv.Atoi(header.Get("retry-after")[0])
+	require.NoError(t, err)
+	require.Positive(t, retryAfter)
+
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(retryAfter)*time.Second, retryInfo.RetryDelay.AsDuration())
+
+	// The right password has to wait too.
+	_, err = client.Login(ctx, &pb.LoginRequest{Email: email, Password: "StrongPass123!"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
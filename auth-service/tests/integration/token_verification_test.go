@@ -0,0 +1,43 @@
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+// A forged x-consumer-id header must not be enough: the service verifies the
+// token itself.
+func TestTamperedAccessTokenIsRejected(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+
+	parts := strings.Split(login.AccessToken, ".")
+	require.Len(t, parts, 3)
+	signature := []byte(parts[2])
+	if signature[0] == 'A' {
+		signature[0] = 'B'
+	} else {
+		signature[0] = 'A'
+	}
+	tampered := parts[0] + "." + parts[1] + "." + string(signature)
+
+	ctx, cancel := authedContext(tampered)
+	defer cancel()
+	_, err := client.GetMe(ctx, &pb.GetMeRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// alg "none" with the claims of the real token.
+	unsigned := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + parts[1] + "."
+	ctx, cancel = authedContext(unsigned)
+	defer cancel()
+	_, err = client.GetMe(ctx, &pb.GetMeRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
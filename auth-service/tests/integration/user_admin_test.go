@@ -0,0 +1,40 @@
+//go:build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "auth-service/gen/go"
+)
+
+func TestUserManagementRequiresAdmin(t *testing.T) {
+	client := newTestClient(t)
+	login := registerAndLogin(t, client)
+	other := registerAndLogin(t, client)
+
+	ctx, cancel := authedContext(login.AccessToken)
+	defer cancel()
+
+	_, err := client.ListUsers(ctx, &pb.ListUsersRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	otherCtx, otherCancel := authedContext(other.AccessToken)
+	defer otherCancel()
+	me, err := client.GetMe(otherCtx, &pb.GetMeRequest{})
+	require.NoError(t, err)
+
+	_, err = client.LockUser(ctx, &pb.LockUserRequest{UserId: me.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.ChangeUserRole(ctx, &pb.ChangeUserRoleRequest{UserId: me.Id, Role: "admin"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The refused lock must not have touched the other user's session.
+	_, err = refresh(client, other.AccessToken, other.RefreshToken)
+	require.NoError(t, err)
+}
@@ -15,8 +15,12 @@ import (
 	"order-service/internal/delivery/grpc/interceptor"
 	"order-service/internal/infrastructure/client"
 	"order-service/internal/infrastructure/config"
+	"order-service/internal/infrastructure/introspection"
 	"order-service/internal/infrastructure/logger"
 	"order-service/internal/infrastructure/persistence/postgres"
+	"order-service/internal/infrastructure/revocation"
+	"order-service/internal/infrastructure/security"
+	"order-service/internal/infrastructure/servicetoken"
 	"order-service/internal/infrastructure/telemetry"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -49,14 +53,64 @@ func main() {
 
 	orderRepo := postgres.NewOrderRepository(db)
 
-	// Initialize user-service client
-	userClient, err := client.NewUserClient(&cfg.Services)
+	// Initialize auth-service client
+	authClient, err := client.NewAuthClient(&cfg.Services)
+	if err != nil {
+		log.Error("failed to initialize auth client", zap.Error(err))
+		panic(err)
+	}
+	defer authClient.Close()
+
+	// This service's own token, for user-service and the auth-service
+	// introspection RPCs
+	serviceTokens := servicetoken.NewSource(
+		authClient,
+		cfg.ServiceAuth.ClientID,
+		cfg.ServiceAuth.ClientSecret,
+		cfg.ServiceAuth.Scope,
+		cfg.ServiceAuth.RefreshBefore,
+	)
+	authClient.UseServiceTokens(serviceTokens)
+
+	// Initialize user-service client, which authenticates as this service
+	userClient, err := client.NewUserClient(&cfg.Services, serviceTokens)
 	if err != nil {
 		log.Error("failed to initialize user client", zap.Error(err))
 		panic(err)
 	}
 	defer userClient.Close()
 
+	// Background refreshes of signing keys and revoked tokens
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// --- Token Verification ---
+	signingKeys := security.NewKeySet(authClient)
+	if err := signingKeys.Refresh(context.Background()); err != nil {
+		// The auth-service may still be starting; tokens with an unknown kid
+		// trigger another fetch.
+		log.Warn("failed to load JWKS", zap.Error(err))
+	}
+	go signingKeys.Run(backgroundCtx, cfg.JWT.JWKSRefreshInterval, log.Logger)
+	tokenVerifier := security.NewTokenVerifier(signingKeys, cfg.JWT.Issuer, cfg.JWT.ClockSkew)
+
+	// --- Token Revocation ---
+	revokedTokens := revocation.NewCache(cfg.Revocation.CacheSize)
+	revocationSyncer := revocation.NewSyncer(revokedTokens, authClient, cfg.Revocation.SyncOverlap)
+	if err := revocationSyncer.Sync(context.Background()); err != nil {
+		// The auth-service may still be starting; the syncer retries.
+		log.Warn("failed to load revoked tokens", zap.Error(err))
+	}
+	go revocationSyncer.Run(backgroundCtx, cfg.Revocation.SyncInterval, log.Logger)
+
+	// --- Token Introspection ---
+	var introspector interceptor.TokenIntrospector
+	if cfg.Introspection.Enabled {
+		introspector = introspection.NewClient(authClient, cfg.Introspection.CacheTTL, cfg.Introspection.CacheSize)
+	} else {
+		log.Warn("token introspection disabled; deactivated and locked users keep access until their tokens expire")
+	}
+
 	// --- Telemetry Initialization ---
 	shutdownTelemetry, err := telemetry.Init("order-service", cfg.Telemetry.CollectorAddr)
 	if err != nil {
@@ -84,7 +138,7 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor()),
+		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor(tokenVerifier, revokedTokens, introspector, authClient)),
 	)
 	proto.RegisterOrderServiceServer(grpcServer, grpcHandler)
 
@@ -766,6 +766,298 @@ func (x *UpdateOrderStatusResponse) GetMessage() string {
 	return ""
 }
 
+type AnonymizeUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserOrdersRequest) Reset() {
+	*x = AnonymizeUserOrdersRequest{}
+	mi := &file_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserOrdersRequest) ProtoMessage() {}
+
+func (x *AnonymizeUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AnonymizeUserOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AnonymizeUserOrdersResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrdersAnonymized int64                  `protobuf:"varint,1,opt,name=orders_anonymized,json=ordersAnonymized,proto3" json:"orders_anonymized,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AnonymizeUserOrdersResponse) Reset() {
+	*x = AnonymizeUserOrdersResponse{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnonymizeUserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonymizeUserOrdersResponse) ProtoMessage() {}
+
+func (x *AnonymizeUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonymizeUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*AnonymizeUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AnonymizeUserOrdersResponse) GetOrdersAnonymized() int64 {
+	if x != nil {
+		return x.OrdersAnonymized
+	}
+	return 0
+}
+
+type ExportUserOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserOrdersRequest) Reset() {
+	*x = ExportUserOrdersRequest{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserOrdersRequest) ProtoMessage() {}
+
+func (x *ExportUserOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUserOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*ExportedOrder       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserOrdersResponse) Reset() {
+	*x = ExportUserOrdersResponse{}
+	mi := &file_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserOrdersResponse) ProtoMessage() {}
+
+func (x *ExportUserOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUserOrdersResponse) GetOrders() []*ExportedOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ExportedOrder struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrderId            string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TotalAmount        float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Items              []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	ShippingAddress    string                 `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	ShippingCity       string                 `protobuf:"bytes,6,opt,name=shipping_city,json=shippingCity,proto3" json:"shipping_city,omitempty"`
+	ShippingCountry    string                 `protobuf:"bytes,7,opt,name=shipping_country,json=shippingCountry,proto3" json:"shipping_country,omitempty"`
+	ShippingPostalCode string                 `protobuf:"bytes,8,opt,name=shipping_postal_code,json=shippingPostalCode,proto3" json:"shipping_postal_code,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExportedOrder) Reset() {
+	*x = ExportedOrder{}
+	mi := &file_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedOrder) ProtoMessage() {}
+
+func (x *ExportedOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedOrder.ProtoReflect.Descriptor instead.
+func (*ExportedOrder) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportedOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *ExportedOrder) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExportedOrder) GetShippingAddress() string {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetShippingCity() string {
+	if x != nil {
+		return x.ShippingCity
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetShippingCountry() string {
+	if x != nil {
+		return x.ShippingCountry
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetShippingPostalCode() string {
+	if x != nil {
+		return x.ShippingPostalCode
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ExportedOrder) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
 var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
@@ -826,14 +1118,38 @@ const file_order_proto_rawDesc = "" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"5\n" +
 	"\x19UpdateOrderStatusResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x95\x04\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"5\n" +
+	"\x1aAnonymizeUserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x1bAnonymizeUserOrdersResponse\x12+\n" +
+	"\x11orders_anonymized\x18\x01 \x01(\x03R\x10ordersAnonymized\"2\n" +
+	"\x17ExportUserOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x18ExportUserOrdersResponse\x12,\n" +
+	"\x06orders\x18\x01 \x03(\v2\x14.proto.ExportedOrderR\x06orders\"\xf8\x02\n" +
+	"\rExportedOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12&\n" +
+	"\x05items\x18\x04 \x03(\v2\x10.proto.OrderItemR\x05items\x12)\n" +
+	"\x10shipping_address\x18\x05 \x01(\tR\x0fshippingAddress\x12#\n" +
+	"\rshipping_city\x18\x06 \x01(\tR\fshippingCity\x12)\n" +
+	"\x10shipping_country\x18\a \x01(\tR\x0fshippingCountry\x120\n" +
+	"\x14shipping_postal_code\x18\b \x01(\tR\x12shippingPostalCode\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt2\xcc\x05\n" +
 	"\fOrderService\x12c\n" +
 	"\vHealthCheck\x12\x19.proto.HealthCheckRequest\x1a\x1a.proto.HealthCheckResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/orders/health\x12_\n" +
 	"\vCreateOrder\x12\x19.proto.CreateOrderRequest\x1a\x1a.proto.CreateOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12^\n" +
 	"\bGetOrder\x12\x16.proto.GetOrderRequest\x1a\x17.proto.GetOrderResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/orders/{order_id}\x12Y\n" +
 	"\n" +
 	"ListOrders\x12\x18.proto.ListOrdersRequest\x1a\x19.proto.ListOrdersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/orders\x12\x83\x01\n" +
-	"\x11UpdateOrderStatus\x12\x1f.proto.UpdateOrderStatusRequest\x1a .proto.UpdateOrderStatusResponse\"+\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/orders/{order_id}/statusB\x16Z\x14order-service/gen/gob\x06proto3"
+	"\x11UpdateOrderStatus\x12\x1f.proto.UpdateOrderStatusRequest\x1a .proto.UpdateOrderStatusResponse\"+\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/orders/{order_id}/status\x12^\n" +
+	"\x13AnonymizeUserOrders\x12!.proto.AnonymizeUserOrdersRequest\x1a\".proto.AnonymizeUserOrdersResponse\"\x00\x12U\n" +
+	"\x10ExportUserOrders\x12\x1e.proto.ExportUserOrdersRequest\x1a\x1f.proto.ExportUserOrdersResponse\"\x00B\x16Z\x14order-service/gen/gob\x06proto3"
 
 var (
 	file_order_proto_rawDescOnce sync.Once
@@ -847,40 +1163,51 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_order_proto_goTypes = []any{
-	(*HealthCheckRequest)(nil),        // 0: proto.HealthCheckRequest
-	(*HealthCheckResponse)(nil),       // 1: proto.HealthCheckResponse
-	(*CreateOrderRequest)(nil),        // 2: proto.CreateOrderRequest
-	(*OrderItem)(nil),                 // 3: proto.OrderItem
-	(*CreateOrderResponse)(nil),       // 4: proto.CreateOrderResponse
-	(*GetOrderRequest)(nil),           // 5: proto.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 6: proto.GetOrderResponse
-	(*ListOrdersRequest)(nil),         // 7: proto.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 8: proto.ListOrdersResponse
-	(*OrderInfo)(nil),                 // 9: proto.OrderInfo
-	(*UpdateOrderStatusRequest)(nil),  // 10: proto.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 11: proto.UpdateOrderStatusResponse
+	(*HealthCheckRequest)(nil),          // 0: proto.HealthCheckRequest
+	(*HealthCheckResponse)(nil),         // 1: proto.HealthCheckResponse
+	(*CreateOrderRequest)(nil),          // 2: proto.CreateOrderRequest
+	(*OrderItem)(nil),                   // 3: proto.OrderItem
+	(*CreateOrderResponse)(nil),         // 4: proto.CreateOrderResponse
+	(*GetOrderRequest)(nil),             // 5: proto.GetOrderRequest
+	(*GetOrderResponse)(nil),            // 6: proto.GetOrderResponse
+	(*ListOrdersRequest)(nil),           // 7: proto.ListOrdersRequest
+	(*ListOrdersResponse)(nil),          // 8: proto.ListOrdersResponse
+	(*OrderInfo)(nil),                   // 9: proto.OrderInfo
+	(*UpdateOrderStatusRequest)(nil),    // 10: proto.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),   // 11: proto.UpdateOrderStatusResponse
+	(*AnonymizeUserOrdersRequest)(nil),  // 12: proto.AnonymizeUserOrdersRequest
+	(*AnonymizeUserOrdersResponse)(nil), // 13: proto.AnonymizeUserOrdersResponse
+	(*ExportUserOrdersRequest)(nil),     // 14: proto.ExportUserOrdersRequest
+	(*ExportUserOrdersResponse)(nil),    // 15: proto.ExportUserOrdersResponse
+	(*ExportedOrder)(nil),               // 16: proto.ExportedOrder
 }
 var file_order_proto_depIdxs = []int32{
 	3,  // 0: proto.CreateOrderRequest.items:type_name -> proto.OrderItem
 	3,  // 1: proto.GetOrderResponse.items:type_name -> proto.OrderItem
 	9,  // 2: proto.ListOrdersResponse.orders:type_name -> proto.OrderInfo
-	0,  // 3: proto.OrderService.HealthCheck:input_type -> proto.HealthCheckRequest
-	2,  // 4: proto.OrderService.CreateOrder:input_type -> proto.CreateOrderRequest
-	5,  // 5: proto.OrderService.GetOrder:input_type -> proto.GetOrderRequest
-	7,  // 6: proto.OrderService.ListOrders:input_type -> proto.ListOrdersRequest
-	10, // 7: proto.OrderService.UpdateOrderStatus:input_type -> proto.UpdateOrderStatusRequest
-	1,  // 8: proto.OrderService.HealthCheck:output_type -> proto.HealthCheckResponse
-	4,  // 9: proto.OrderService.CreateOrder:output_type -> proto.CreateOrderResponse
-	6,  // 10: proto.OrderService.GetOrder:output_type -> proto.GetOrderResponse
-	8,  // 11: proto.OrderService.ListOrders:output_type -> proto.ListOrdersResponse
-	11, // 12: proto.OrderService.UpdateOrderStatus:output_type -> proto.UpdateOrderStatusResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	16, // 3: proto.ExportUserOrdersResponse.orders:type_name -> proto.ExportedOrder
+	3,  // 4: proto.ExportedOrder.items:type_name -> proto.OrderItem
+	0,  // 5: proto.OrderService.HealthCheck:input_type -> proto.HealthCheckRequest
+	2,  // 6: proto.OrderService.CreateOrder:input_type -> proto.CreateOrderRequest
+	5,  // 7: proto.OrderService.GetOrder:input_type -> proto.GetOrderRequest
+	7,  // 8: proto.OrderService.ListOrders:input_type -> proto.ListOrdersRequest
+	10, // 9: proto.OrderService.UpdateOrderStatus:input_type -> proto.UpdateOrderStatusRequest
+	12, // 10: proto.OrderService.AnonymizeUserOrders:input_type -> proto.AnonymizeUserOrdersRequest
+	14, // 11: proto.OrderService.ExportUserOrders:input_type -> proto.ExportUserOrdersRequest
+	1,  // 12: proto.OrderService.HealthCheck:output_type -> proto.HealthCheckResponse
+	4,  // 13: proto.OrderService.CreateOrder:output_type -> proto.CreateOrderResponse
+	6,  // 14: proto.OrderService.GetOrder:output_type -> proto.GetOrderResponse
+	8,  // 15: proto.OrderService.ListOrders:output_type -> proto.ListOrdersResponse
+	11, // 16: proto.OrderService.UpdateOrderStatus:output_type -> proto.UpdateOrderStatusResponse
+	13, // 17: proto.OrderService.AnonymizeUserOrders:output_type -> proto.AnonymizeUserOrdersResponse
+	15, // 18: proto.OrderService.ExportUserOrders:output_type -> proto.ExportUserOrdersResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -894,7 +1221,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
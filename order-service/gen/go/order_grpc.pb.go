@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_HealthCheck_FullMethodName       = "/proto.OrderService/HealthCheck"
-	OrderService_CreateOrder_FullMethodName       = "/proto.OrderService/CreateOrder"
-	OrderService_GetOrder_FullMethodName          = "/proto.OrderService/GetOrder"
-	OrderService_ListOrders_FullMethodName        = "/proto.OrderService/ListOrders"
-	OrderService_UpdateOrderStatus_FullMethodName = "/proto.OrderService/UpdateOrderStatus"
+	OrderService_HealthCheck_FullMethodName         = "/proto.OrderService/HealthCheck"
+	OrderService_CreateOrder_FullMethodName         = "/proto.OrderService/CreateOrder"
+	OrderService_GetOrder_FullMethodName            = "/proto.OrderService/GetOrder"
+	OrderService_ListOrders_FullMethodName          = "/proto.OrderService/ListOrders"
+	OrderService_UpdateOrderStatus_FullMethodName   = "/proto.OrderService/UpdateOrderStatus"
+	OrderService_AnonymizeUserOrders_FullMethodName = "/proto.OrderService/AnonymizeUserOrders"
+	OrderService_ExportUserOrders_FullMethodName    = "/proto.OrderService/ExportUserOrders"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -35,6 +37,12 @@ type OrderServiceClient interface {
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	// Internal: called by the auth-service when an account is deleted. Not
+	// exposed through the gateway.
+	AnonymizeUserOrders(ctx context.Context, in *AnonymizeUserOrdersRequest, opts ...grpc.CallOption) (*AnonymizeUserOrdersResponse, error)
+	// Internal: called by the auth-service to build a personal data export.
+	// Not exposed through the gateway.
+	ExportUserOrders(ctx context.Context, in *ExportUserOrdersRequest, opts ...grpc.CallOption) (*ExportUserOrdersResponse, error)
 }
 
 type orderServiceClient struct {
@@ -95,6 +103,26 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *orderServiceClient) AnonymizeUserOrders(ctx context.Context, in *AnonymizeUserOrdersRequest, opts ...grpc.CallOption) (*AnonymizeUserOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_AnonymizeUserOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportUserOrders(ctx context.Context, in *ExportUserOrdersRequest, opts ...grpc.CallOption) (*ExportUserOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ExportUserOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -104,6 +132,12 @@ type OrderServiceServer interface {
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	// Internal: called by the auth-service when an account is deleted. Not
+	// exposed through the gateway.
+	AnonymizeUserOrders(context.Context, *AnonymizeUserOrdersRequest) (*AnonymizeUserOrdersResponse, error)
+	// Internal: called by the auth-service to build a personal data export.
+	// Not exposed through the gateway.
+	ExportUserOrders(context.Context, *ExportUserOrdersRequest) (*ExportUserOrdersResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -129,6 +163,12 @@ func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRe
 func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) AnonymizeUserOrders(context.Context, *AnonymizeUserOrdersRequest) (*AnonymizeUserOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUserOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportUserOrders(context.Context, *ExportUserOrdersRequest) (*ExportUserOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserOrders not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +280,42 @@ func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_AnonymizeUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnonymizeUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AnonymizeUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AnonymizeUserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AnonymizeUserOrders(ctx, req.(*AnonymizeUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ExportUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ExportUserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ExportUserOrders(ctx, req.(*ExportUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +343,14 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _OrderService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "AnonymizeUserOrders",
+			Handler:    _OrderService_AnonymizeUserOrders_Handler,
+		},
+		{
+			MethodName: "ExportUserOrders",
+			Handler:    _OrderService_ExportUserOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
@@ -24,14 +24,9 @@ func NewOrderUseCase(orderRepo repository.OrderRepository, userClient *client.Us
 	}
 }
 
-func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID string, req dto.CreateOrderRequest) (*dto.OrderDTO, error) {
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, domainErr.ErrInvalidInput
-	}
-
+func (uc *OrderUseCase) CreateOrder(ctx context.Context, identity *entity.Identity, req dto.CreateOrderRequest) (*dto.OrderDTO, error) {
 	// Validate user-service is available
-	err = uc.userClient.GetUser(ctx, userID)
+	err := uc.userClient.GetUser(ctx, identity.UserID.String())
 	if err != nil {
 		// For MVP, we'll continue even if user-service is unavailable
 		// In production, you might want to fail or use a circuit breaker
@@ -56,7 +51,7 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID string, req dto.
 	}
 
 	order := entity.NewOrder(
-		userUUID,
+		identity.UserID,
 		items,
 		req.ShippingAddress,
 		req.ShippingCity,
@@ -76,27 +71,16 @@ func (uc *OrderUseCase) CreateOrder(ctx context.Context, userID string, req dto.
 	return uc.toDTO(order), nil
 }
 
-func (uc *OrderUseCase) GetOrder(ctx context.Context, orderID string, userID string) (*dto.OrderDTO, error) {
-	orderUUID, err := uuid.Parse(orderID)
-	if err != nil {
-		return nil, domainErr.ErrInvalidInput
-	}
-
-	order, err := uc.orderRepo.FindByID(ctx, orderUUID)
+func (uc *OrderUseCase) GetOrder(ctx context.Context, identity *entity.Identity, orderID string) (*dto.OrderDTO, error) {
+	order, err := uc.findAccessibleOrder(ctx, identity, orderID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Users can only view their own orders (unless admin)
-	userUUID, _ := uuid.Parse(userID)
-	if order.UserID != userUUID {
-		return nil, domainErr.ErrForbidden
-	}
-
 	return uc.toDTO(order), nil
 }
 
-func (uc *OrderUseCase) ListOrders(ctx context.Context, userID string, page, pageSize int32) (*dto.ListOrdersResponse, error) {
+func (uc *OrderUseCase) ListOrders(ctx context.Context, identity *entity.Identity, page, pageSize int32) (*dto.ListOrdersResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -107,15 +91,10 @@ func (uc *OrderUseCase) ListOrders(ctx context.Context, userID string, page, pag
 		pageSize = 100
 	}
 
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, domainErr.ErrInvalidInput
-	}
-
 	limit := int(pageSize)
 	offset := int((page - 1) * pageSize)
 
-	orders, total, err := uc.orderRepo.FindByUserID(ctx, userUUID, limit, offset)
+	orders, total, err := uc.orderRepo.FindByUserID(ctx, identity.UserID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -139,23 +118,12 @@ func (uc *OrderUseCase) ListOrders(ctx context.Context, userID string, page, pag
 	}, nil
 }
 
-func (uc *OrderUseCase) UpdateOrderStatus(ctx context.Context, orderID string, userID string, status string) error {
-	orderUUID, err := uuid.Parse(orderID)
-	if err != nil {
-		return domainErr.ErrInvalidInput
-	}
-
-	order, err := uc.orderRepo.FindByID(ctx, orderUUID)
+func (uc *OrderUseCase) UpdateOrderStatus(ctx context.Context, identity *entity.Identity, orderID string, status string) error {
+	order, err := uc.findAccessibleOrder(ctx, identity, orderID)
 	if err != nil {
 		return err
 	}
 
-	// Users can only update their own orders (unless admin)
-	userUUID, _ := uuid.Parse(userID)
-	if order.UserID != userUUID {
-		return domainErr.ErrForbidden
-	}
-
 	newStatus := entity.OrderStatus(status)
 	if !order.CanUpdateStatus(newStatus) {
 		return domainErr.ErrStatusTransition
@@ -166,6 +134,26 @@ func (uc *OrderUseCase) UpdateOrderStatus(ctx context.Context, orderID string, u
 	return uc.orderRepo.Update(ctx, order)
 }
 
+// findAccessibleOrder loads an order the caller owns, or any order for an
+// admin. Other users' orders are reported as not found, so order IDs cannot be
+// probed.
+func (uc *OrderUseCase) findAccessibleOrder(ctx context.Context, identity *entity.Identity, orderID string) (*entity.Order, error) {
+	orderUUID, err := uuid.Parse(orderID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	order, err := uc.orderRepo.FindByID(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !identity.CanAccess(order) {
+		return nil, domainErr.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
 func (uc *OrderUseCase) toDTO(order *entity.Order) *dto.OrderDTO {
 	items := make([]dto.OrderItemDTO, len(order.Items))
 	for i, item := range order.Items {
@@ -192,3 +180,40 @@ func (uc *OrderUseCase) toDTO(order *entity.Order) *dto.OrderDTO {
 	}
 }
 
+// AnonymizeUserOrders removes the personal data from the user's orders after
+// the account was deleted in the auth-service. It is idempotent, so the
+// auth-service can retry it.
+func (uc *OrderUseCase) AnonymizeUserOrders(ctx context.Context, userID string) (int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, domainErr.ErrInvalidInput
+	}
+
+	return uc.orderRepo.AnonymizeByUserID(ctx, userUUID)
+}
+
+// exportPageSize is how many orders ExportUserOrders reads at a time.
+const exportPageSize = 100
+
+// ExportUserOrders returns all of the user's orders with their items, newest
+// first, for a personal data export requested through the auth-service.
+func (uc *OrderUseCase) ExportUserOrders(ctx context.Context, userID string) ([]*dto.OrderDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	var result []*dto.OrderDTO
+	for offset := 0; ; offset += exportPageSize {
+		orders, total, err := uc.orderRepo.FindByUserID(ctx, userUUID, exportPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			result = append(result, uc.toDTO(order))
+		}
+		if len(orders) < exportPageSize || int64(offset+len(orders)) >= total {
+			return result, nil
+		}
+	}
+}
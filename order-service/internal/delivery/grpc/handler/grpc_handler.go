@@ -25,7 +25,7 @@ func (h *GRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckReq
 }
 
 func (h *GRPCHandler) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest) (*proto.CreateOrderResponse, error) {
-	userID, err := interceptor.GetUserIDFromContext(ctx)
+	identity, err := interceptor.GetIdentityFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func (h *GRPCHandler) CreateOrder(ctx context.Context, req *proto.CreateOrderReq
 		ShippingPostalCode: req.GetShippingPostalCode(),
 	}
 
-	order, err := h.orderUsecase.CreateOrder(ctx, userID, createDTO)
+	order, err := h.orderUsecase.CreateOrder(ctx, identity, createDTO)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -60,32 +60,22 @@ func (h *GRPCHandler) CreateOrder(ctx context.Context, req *proto.CreateOrderReq
 }
 
 func (h *GRPCHandler) GetOrder(ctx context.Context, req *proto.GetOrderRequest) (*proto.GetOrderResponse, error) {
-	userID, err := interceptor.GetUserIDFromContext(ctx)
+	identity, err := interceptor.GetIdentityFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	order, err := h.orderUsecase.GetOrder(ctx, req.GetOrderId(), userID)
+	order, err := h.orderUsecase.GetOrder(ctx, identity, req.GetOrderId())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	items := make([]*proto.OrderItem, len(order.Items))
-	for i, item := range order.Items {
-		items[i] = &proto.OrderItem{
-			ProductId:   item.ProductID,
-			ProductName: item.ProductName,
-			Quantity:    item.Quantity,
-			Price:       item.Price,
-		}
-	}
-
 	return &proto.GetOrderResponse{
 		OrderId:           order.OrderID,
 		UserId:            order.UserID,
 		Status:            order.Status,
 		TotalAmount:       order.TotalAmount,
-		Items:             items,
+		Items:             toOrderItemsProto(order.Items),
 		ShippingAddress:   order.ShippingAddress,
 		ShippingCity:      order.ShippingCity,
 		ShippingCountry:   order.ShippingCountry,
@@ -96,7 +86,7 @@ func (h *GRPCHandler) GetOrder(ctx context.Context, req *proto.GetOrderRequest)
 }
 
 func (h *GRPCHandler) ListOrders(ctx context.Context, req *proto.ListOrdersRequest) (*proto.ListOrdersResponse, error) {
-	userID, err := interceptor.GetUserIDFromContext(ctx)
+	identity, err := interceptor.GetIdentityFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +100,7 @@ func (h *GRPCHandler) ListOrders(ctx context.Context, req *proto.ListOrdersReque
 		pageSize = 10
 	}
 
-	result, err := h.orderUsecase.ListOrders(ctx, userID, page, pageSize)
+	result, err := h.orderUsecase.ListOrders(ctx, identity, page, pageSize)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
@@ -135,15 +125,75 @@ func (h *GRPCHandler) ListOrders(ctx context.Context, req *proto.ListOrdersReque
 }
 
 func (h *GRPCHandler) UpdateOrderStatus(ctx context.Context, req *proto.UpdateOrderStatusRequest) (*proto.UpdateOrderStatusResponse, error) {
-	userID, err := interceptor.GetUserIDFromContext(ctx)
+	identity, err := interceptor.GetIdentityFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := h.orderUsecase.UpdateOrderStatus(ctx, req.GetOrderId(), userID, req.GetStatus()); err != nil {
+	if err := h.orderUsecase.UpdateOrderStatus(ctx, identity, req.GetOrderId(), req.GetStatus()); err != nil {
 		return nil, toGRPCError(err)
 	}
 
 	return &proto.UpdateOrderStatusResponse{Message: "order status updated successfully"}, nil
 }
 
+
+// AnonymizeUserOrders is called by the auth-service, as a service principal,
+// when an account is deleted.
+func (h *GRPCHandler) AnonymizeUserOrders(ctx context.Context, req *proto.AnonymizeUserOrdersRequest) (*proto.AnonymizeUserOrdersResponse, error) {
+	if _, err := interceptor.RequireServiceFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	count, err := h.orderUsecase.AnonymizeUserOrders(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &proto.AnonymizeUserOrdersResponse{OrdersAnonymized: count}, nil
+}
+
+// ExportUserOrders is called by the auth-service, as a service principal,
+// when a user exports their personal data.
+func (h *GRPCHandler) ExportUserOrders(ctx context.Context, req *proto.ExportUserOrdersRequest) (*proto.ExportUserOrdersResponse, error) {
+	if _, err := interceptor.RequireServiceFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	orders, err := h.orderUsecase.ExportUserOrders(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &proto.ExportUserOrdersResponse{
+		Orders: make([]*proto.ExportedOrder, len(orders)),
+	}
+	for i, order := range orders {
+		resp.Orders[i] = &proto.ExportedOrder{
+			OrderId:            order.OrderID,
+			Status:             order.Status,
+			TotalAmount:        order.TotalAmount,
+			Items:              toOrderItemsProto(order.Items),
+			ShippingAddress:    order.ShippingAddress,
+			ShippingCity:       order.ShippingCity,
+			ShippingCountry:    order.ShippingCountry,
+			ShippingPostalCode: order.ShippingPostalCode,
+			CreatedAt:          order.CreatedAt,
+			UpdatedAt:          order.UpdatedAt,
+		}
+	}
+	return resp, nil
+}
+
+func toOrderItemsProto(items []dto.OrderItemDTO) []*proto.OrderItem {
+	result := make([]*proto.OrderItem, len(items))
+	for i, item := range items {
+		result[i] = &proto.OrderItem{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Price:       item.Price,
+		}
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	proto "order-service/gen/go"
+	"order-service/internal/application/usecase"
+	"order-service/internal/delivery/grpc/interceptor"
+	"order-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userContext is the context the auth interceptor builds for a user token.
+func userContext() context.Context {
+	return context.WithValue(context.Background(), interceptor.IdentityKey, &entity.Identity{UserID: uuid.New(), Role: entity.RoleAdmin})
+}
+
+func TestUserCannotExportAnotherUsersOrders(t *testing.T) {
+	h := NewGRPCHandler(usecase.OrderUseCase{})
+
+	_, err := h.ExportUserOrders(userContext(), &proto.ExportUserOrdersRequest{UserId: uuid.NewString()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
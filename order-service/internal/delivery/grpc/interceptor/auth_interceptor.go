@@ -5,6 +5,11 @@ import (
 	"log"
 	"strings"
 
+	"order-service/internal/domain/entity"
+	"order-service/internal/infrastructure/introspection"
+	"order-service/internal/infrastructure/security"
+
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -14,19 +19,67 @@ import (
 type contextKey string
 
 const (
-	UserIDKey      contextKey = "user_id"
-	UserEmailKey   contextKey = "user_email"
-	UserRoleKey    contextKey = "user_role"
+	IdentityKey    contextKey = "identity"
 	ClientIPKey    contextKey = "client_ip"
 	UserAgentKey   contextKey = "user_agent"
 	AccessTokenKey contextKey = "access_token"
+	ServiceIDKey   contextKey = "service_client_id"
 )
 
 var publicMethods = map[string]bool{
 	"/proto.OrderService/HealthCheck": true,
 }
 
-func NewAuthInterceptor() grpc.UnaryServerInterceptor {
+// serviceMethods are the internal RPCs other services may call with a
+// machine-client token, and the scope each one requires. Service principals
+// cannot call any other method, and no one else can call these.
+var serviceMethods = map[string]string{
+	"/proto.OrderService/AnonymizeUserOrders": "orders.anonymize",
+	"/proto.OrderService/ExportUserOrders":    "orders.export",
+}
+
+// apiKeyMethods are the RPCs personal API keys may call and the scope each
+// one requires. Every other method needs an access token.
+var apiKeyMethods = map[string]string{
+	"/proto.OrderService/CreateOrder":       "orders.write",
+	"/proto.OrderService/GetOrder":          "orders.read",
+	"/proto.OrderService/ListOrders":        "orders.read",
+	"/proto.OrderService/UpdateOrderStatus": "orders.write",
+}
+
+// RevocationChecker reports whether an access token was revoked, e.g. by
+// logout, or every token of its user was, as when the account is deleted.
+// It must not call out per request; see the revocation package.
+type RevocationChecker interface {
+	IsRevoked(token, userID string) bool
+}
+
+// TokenIntrospector asks the auth-service whether a token is still active,
+// which also covers deactivated and locked users. Implementations should
+// cache; see the introspection package.
+type TokenIntrospector interface {
+	Introspect(ctx context.Context, token string) (*introspection.Result, error)
+}
+
+// APIKeyIntrospector resolves a personal API key to its owner and scopes
+// through the auth-service, which records the use; client.AuthClient
+// implements it.
+type APIKeyIntrospector interface {
+	IntrospectAPIKey(ctx context.Context, key string) (*introspection.APIKeyResult, error)
+}
+
+// TokenVerifier checks an access token's signature and its exp, nbf and iss
+// claims; see security.TokenVerifier.
+type TokenVerifier interface {
+	Verify(token string) (*security.Claims, error)
+}
+
+// NewAuthInterceptor authenticates requests by their bearer token, which is
+// verified here rather than trusted because Kong forwarded it; the service is
+// safe to call around the gateway. If introspector is nil, introspection is
+// skipped and the identity is taken from the verified claims. Requests with
+// "Authorization: ApiKey <key>" are resolved through apiKeys instead.
+func NewAuthInterceptor(verifier TokenVerifier, revocations RevocationChecker, introspector TokenIntrospector, apiKeys APIKeyIntrospector) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -46,43 +99,159 @@ func NewAuthInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		// Check if request was authenticated by Kong Gateway
-		if kongConsumerID := md.Get("x-consumer-id"); len(kongConsumerID) > 0 {
-			log.Println("✅ Request authenticated by Kong Gateway, consumer:", kongConsumerID[0])
-
-			// Extract JWT token and parse claims (Kong already validated it)
-			authHeaders := md.Get("authorization")
-			if len(authHeaders) > 0 {
-				parts := strings.SplitN(authHeaders[0], " ", 2)
-				if len(parts) == 2 {
-					token := parts[1]
-					// For order-service, we'll extract user info from Kong headers
-					if customID := md.Get("x-consumer-custom-id"); len(customID) > 0 {
-						ctx = context.WithValue(ctx, UserIDKey, customID[0])
-					}
-					if username := md.Get("x-consumer-username"); len(username) > 0 {
-						ctx = context.WithValue(ctx, UserEmailKey, username[0])
-					}
-					ctx = context.WithValue(ctx, AccessTokenKey, token)
-					return handler(ctx, req)
-				}
+		if key := apiKey(md); key != "" {
+			ctx, err := authenticateAPIKey(ctx, info.FullMethod, apiKeys, key)
+			if err != nil {
+				return nil, err
 			}
+			return handler(ctx, req)
+		}
 
-			return nil, status.Error(codes.Internal, "failed to extract user info from JWT")
+		token := bearerToken(md)
+		if token == "" {
+			log.Println("❌ Request without bearer token")
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
 		}
 
-		// If no Kong consumer header, request is unauthenticated
-		log.Println("❌ Request not authenticated by Kong Gateway")
-		return nil, status.Error(codes.Unauthenticated, "unauthorized: request must go through API gateway")
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			log.Printf("⚠️  Rejected access token: %v", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		// Kong cannot see revocations, so logged-out tokens are rejected here.
+		if revocations.IsRevoked(token, claims.UserID) {
+			return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+		}
+
+		ctx = context.WithValue(ctx, AccessTokenKey, token)
+		if claims.IsServicePrincipal() {
+			return authorizeService(ctx, req, info, handler, claims)
+		}
+		if _, ok := serviceMethods[info.FullMethod]; ok {
+			return nil, status.Error(codes.PermissionDenied, "method is only available to service clients")
+		}
+		if claims.IsDelegated() {
+			return nil, status.Error(codes.PermissionDenied, "method is not available to oauth clients")
+		}
+		if introspector != nil {
+			result, err := introspector.Introspect(ctx, token)
+			if err != nil {
+				log.Printf("⚠️  Token introspection failed: %v", err)
+				return nil, status.Error(codes.Unavailable, "token introspection unavailable")
+			}
+			if !result.Active {
+				return nil, status.Error(codes.Unauthenticated, "token is not active")
+			}
+			ctx, err = withIdentity(ctx, result.UserID, result.Email, result.Role)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
+		ctx, err = withIdentity(ctx, claims.UserID, claims.Email, claims.Role)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
 	}
 }
 
-func GetUserIDFromContext(ctx context.Context) (string, error) {
-	userID, ok := ctx.Value(UserIDKey).(string)
-	if !ok || userID == "" {
-		return "", status.Error(codes.Unauthenticated, "user id not found in context")
+// authorizeService admits a machine client to the internal RPCs its scopes
+// allow. Introspection is skipped: there is no user to check, and service
+// tokens are short-lived.
+func authorizeService(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, claims *security.Claims) (interface{}, error) {
+	scope, ok := serviceMethods[info.FullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method is not available to service clients")
+	}
+	if !claims.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "token lacks the %s scope", scope)
+	}
+
+	ctx = context.WithValue(ctx, ServiceIDKey, claims.ClientID)
+	return handler(ctx, req)
+}
+
+// authenticateAPIKey identifies the caller as the owner of a personal API
+// key, provided the method is open to API keys and the key has its scope.
+func authenticateAPIKey(ctx context.Context, method string, apiKeys APIKeyIntrospector, key string) (context.Context, error) {
+	scope, ok := apiKeyMethods[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method cannot be called with an api key")
+	}
+
+	result, err := apiKeys.IntrospectAPIKey(ctx, key)
+	if err != nil {
+		log.Printf("⚠️  API key introspection failed: %v", err)
+		return nil, status.Error(codes.Unavailable, "api key introspection unavailable")
+	}
+	if !result.Active {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	if !result.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key lacks the %s scope", scope)
+	}
+
+	return withIdentity(ctx, result.UserID, result.Email, result.Role)
+}
+
+func withIdentity(ctx context.Context, userID, email, role string) (context.Context, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		log.Printf("⚠️  Access token subject is not a user ID: %q", userID)
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	identity := &entity.Identity{UserID: id, Email: email, Role: role}
+	return context.WithValue(ctx, IdentityKey, identity), nil
+}
+
+func bearerToken(md metadata.MD) string {
+	return credentials(md, "Bearer")
+}
+
+func apiKey(md metadata.MD) string {
+	return credentials(md, "ApiKey")
+}
+
+// credentials returns the authorization header's credentials if it uses
+// scheme.
+func credentials(md metadata.MD, scheme string) string {
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return ""
+	}
+	parts := strings.SplitN(authHeaders[0], " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], scheme) {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
+
+// GetIdentityFromContext returns the caller set by the auth interceptor.
+func GetIdentityFromContext(ctx context.Context) (*entity.Identity, error) {
+	identity, ok := ctx.Value(IdentityKey).(*entity.Identity)
+	if !ok || identity == nil {
+		return nil, status.Error(codes.Unauthenticated, "identity not found in context")
+	}
+	return identity, nil
+}
+
+// GetServiceIDFromContext returns the client ID of the calling service, or
+// "" if the caller is a user.
+func GetServiceIDFromContext(ctx context.Context) string {
+	clientID, _ := ctx.Value(ServiceIDKey).(string)
+	return clientID
+}
+
+// RequireServiceFromContext returns the client ID of the calling service and
+// denies callers that are not service principals.
+func RequireServiceFromContext(ctx context.Context) (string, error) {
+	clientID := GetServiceIDFromContext(ctx)
+	if clientID == "" {
+		return "", status.Error(codes.PermissionDenied, "method is only available to service clients")
 	}
-	return userID, nil
+	return clientID, nil
 }
 
 func GetClientIPFromContext(ctx context.Context) string {
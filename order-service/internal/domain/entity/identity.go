@@ -0,0 +1,24 @@
+package entity
+
+import "github.com/google/uuid"
+
+const RoleAdmin = "admin"
+
+// Identity is the caller of a request. The auth interceptor builds it from a
+// verified access token; it is never taken from gateway headers, since every
+// request through Kong shares one consumer.
+type Identity struct {
+	UserID uuid.UUID
+	Email  string
+	Role   string
+}
+
+func (i *Identity) IsAdmin() bool {
+	return i.Role == RoleAdmin
+}
+
+// CanAccess reports whether the caller may read or change order: its owner
+// or an admin.
+func (i *Identity) CanAccess(order *Order) bool {
+	return i.IsAdmin() || order.UserID == i.UserID
+}
@@ -14,5 +14,9 @@ type OrderRepository interface {
 	FindByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.Order, int64, error)
 	Update(ctx context.Context, order *entity.Order) error
 	List(ctx context.Context, limit, offset int) ([]*entity.Order, int64, error)
+	// AnonymizeByUserID clears the shipping address, city and postal code of
+	// the user's orders, keeping the country, amounts and items for the
+	// financial records. It returns the number of orders changed.
+	AnonymizeByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 }
 
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	proto "order-service/gen/go"
+	"order-service/internal/infrastructure/config"
+	"order-service/internal/infrastructure/introspection"
+	"order-service/internal/infrastructure/revocation"
+	"order-service/internal/infrastructure/security"
+	"order-service/internal/infrastructure/servicetoken"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// serviceMethods are the auth-service RPCs that only accept this service's
+// own token, with the tokens.introspect scope.
+var serviceMethods = map[string]bool{
+	proto.AuthService_ListRevokedTokens_FullMethodName: true,
+	proto.AuthService_IntrospectToken_FullMethodName:   true,
+	proto.AuthService_IntrospectAPIKey_FullMethodName:  true,
+}
+
+type AuthClient struct {
+	conn   *grpc.ClientConn
+	auth   proto.AuthServiceClient
+	tokens *servicetoken.Source
+}
+
+func NewAuthClient(cfg *config.ServicesConfig) (*AuthClient, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &AuthClient{}
+	conn, err := grpc.NewClient(
+		cfg.AuthServiceAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(c.authenticateServiceCalls),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.conn = conn
+	c.auth = proto.NewAuthServiceClient(conn)
+	return c, nil
+}
+
+// UseServiceTokens sets the source of the token sent on serviceMethods. The
+// source fetches its token through this client, so it is set once both
+// exist and before the client is used.
+func (c *AuthClient) UseServiceTokens(tokens *servicetoken.Source) {
+	c.tokens = tokens
+}
+
+func (c *AuthClient) authenticateServiceCalls(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !serviceMethods[method] || c.tokens == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return servicetoken.UnaryClientInterceptor(c.tokens)(ctx, method, req, reply, cc, invoker, opts...)
+}
+
+// FetchJWKS implements security.KeySource with the auth-service GetJWKS RPC.
+func (c *AuthClient) FetchJWKS(ctx context.Context) ([]security.JSONWebKey, error) {
+	resp, err := c.auth.GetJWKS(ctx, &proto.GetJWKSRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]security.JSONWebKey, len(resp.GetKeys()))
+	for i, key := range resp.GetKeys() {
+		keys[i] = security.JSONWebKey{
+			KeyID:     key.GetKid(),
+			KeyType:   key.GetKty(),
+			Algorithm: key.GetAlg(),
+			Curve:     key.GetCrv(),
+			N:         key.GetN(),
+			E:         key.GetE(),
+			X:         key.GetX(),
+			Y:         key.GetY(),
+		}
+	}
+	return keys, nil
+}
+
+// RevokedSince implements revocation.Feed with the auth-service
+// ListRevokedTokens RPC.
+func (c *AuthClient) RevokedSince(ctx context.Context, since time.Time) ([]revocation.Entry, time.Time, bool, error) {
+	req := &proto.ListRevokedTokensRequest{}
+	if !since.IsZero() {
+		req.SinceUnixMs = since.UnixMilli()
+	}
+
+	resp, err := c.auth.ListRevokedTokens(ctx, req)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	entries := make([]revocation.Entry, len(resp.GetTokens()))
+	for i, token := range resp.GetTokens() {
+		entries[i] = revocation.Entry{
+			TokenHash: token.GetTokenHash(),
+			ExpiresAt: time.Unix(token.GetExpiresAtUnix(), 0),
+		}
+	}
+
+	return entries, time.UnixMilli(resp.GetNextSinceUnixMs()), resp.GetHasMore(), nil
+}
+
+// IntrospectToken implements introspection.Source with the auth-service
+// IntrospectToken RPC.
+func (c *AuthClient) IntrospectToken(ctx context.Context, token string) (*introspection.Result, error) {
+	resp, err := c.auth.IntrospectToken(ctx, &proto.IntrospectTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetActive() {
+		return &introspection.Result{Active: false}, nil
+	}
+
+	return &introspection.Result{
+		Active:    true,
+		UserID:    resp.GetSub(),
+		Email:     resp.GetEmail(),
+		Role:      resp.GetRole(),
+		SessionID: resp.GetSid(),
+		ExpiresAt: time.Unix(resp.GetExp(), 0),
+	}, nil
+}
+
+// ClientCredentialsToken implements servicetoken.Issuer with the
+// auth-service OAuthToken RPC.
+func (c *AuthClient) ClientCredentialsToken(ctx context.Context, clientID, clientSecret, scope string) (*servicetoken.Token, error) {
+	resp, err := c.auth.OAuthToken(ctx, &proto.OAuthTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+		Scope:        scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &servicetoken.Token{
+		AccessToken: resp.GetAccessToken(),
+		ExpiresAt:   time.Now().Add(time.Duration(resp.GetExpiresIn()) * time.Second),
+	}, nil
+}
+
+// IntrospectAPIKey resolves a personal API key with the auth-service
+// IntrospectAPIKey RPC.
+func (c *AuthClient) IntrospectAPIKey(ctx context.Context, key string) (*introspection.APIKeyResult, error) {
+	resp, err := c.auth.IntrospectAPIKey(ctx, &proto.IntrospectAPIKeyRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetActive() {
+		return &introspection.APIKeyResult{Active: false}, nil
+	}
+
+	return &introspection.APIKeyResult{
+		Active: true,
+		KeyID:  resp.GetKeyId(),
+		UserID: resp.GetSub(),
+		Email:  resp.GetEmail(),
+		Role:   resp.GetRole(),
+		Scopes: resp.GetScopes(),
+	}, nil
+}
+
+func (c *AuthClient) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"crypto/tls"
+
+	"order-service/internal/infrastructure/config"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// transportCredentials secures the connections to other services as cfg
+// asks. Without TLS they are plaintext, which is only fit for a private
+// network such as the docker-compose one.
+func transportCredentials(cfg *config.ServicesConfig) (credentials.TransportCredentials, error) {
+	if !cfg.TLSEnabled {
+		return insecure.NewCredentials(), nil
+	}
+	if cfg.TLSCAFile != "" {
+		return credentials.NewClientTLSFromFile(cfg.TLSCAFile, "")
+	}
+	return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}), nil
+}
@@ -3,45 +3,54 @@ package client
 import (
 	"context"
 
+	proto "order-service/gen/go"
 	"order-service/internal/infrastructure/config"
+	"order-service/internal/infrastructure/servicetoken"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserClient struct {
-	conn   *grpc.ClientConn
-	health grpc_health_v1.HealthClient
+	conn  *grpc.ClientConn
+	users proto.UserServiceClient
 }
 
-func NewUserClient(cfg *config.ServicesConfig) (*UserClient, error) {
+// NewUserClient calls user-service as this service, with the machine-client
+// token from tokens rather than the end user's token.
+func NewUserClient(cfg *config.ServicesConfig, tokens *servicetoken.Source) (*UserClient, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
 	conn, err := grpc.NewClient(
 		cfg.UserServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(servicetoken.UnaryClientInterceptor(tokens)),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &UserClient{
-		conn:   conn,
-		health: grpc_health_v1.NewHealthClient(conn),
+		conn:  conn,
+		users: proto.NewUserServiceClient(conn),
 	}, nil
 }
 
-// GetUser validates that user-service is available
-// For MVP, we just check if the service is healthy
+// GetUser checks the user with user-service. Profiles are created on first
+// access, so a user without one yet is not an error.
 func (c *UserClient) GetUser(ctx context.Context, userID string) error {
-	_, err := c.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{
-		Service: "user-service",
-	})
+	_, err := c.users.GetUser(ctx, &proto.GetUserRequest{UserId: userID})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
 	return err
 }
 
 func (c *UserClient) Close() error {
 	return c.conn.Close()
 }
-
@@ -10,11 +10,15 @@ import (
 )
 
 type Config struct {
-	Environment string
-	Server      ServerConfig
-	Database    DatabaseConfig
-	Telemetry   TelemetryConfig
-	Services    ServicesConfig
+	Environment   string
+	Server        ServerConfig
+	Database      DatabaseConfig
+	Telemetry     TelemetryConfig
+	Services      ServicesConfig
+	JWT           JWTConfig
+	Revocation    RevocationConfig
+	Introspection IntrospectionConfig
+	ServiceAuth   ServiceAuthConfig
 }
 
 type TelemetryConfig struct {
@@ -40,8 +44,50 @@ type DatabaseConfig struct {
 	ConnMaxLifetime time.Duration
 }
 
+// ServicesConfig holds the addresses of the services this one calls. With
+// TLSEnabled the calls use TLS, verified against the CA in TLSCAFile or, if
+// it is empty, the system roots; the machine-client secret is sent to the
+// auth-service on these connections.
 type ServicesConfig struct {
 	UserServiceAddr string
+	AuthServiceAddr string
+	TLSEnabled      bool
+	TLSCAFile       string
+}
+
+// JWTConfig controls local verification of access tokens against the
+// auth-service JWKS.
+type JWTConfig struct {
+	Issuer              string
+	ClockSkew           time.Duration
+	JWKSRefreshInterval time.Duration
+}
+
+// RevocationConfig controls the in-memory cache of revoked access tokens,
+// which is filled from the auth-service revocation feed.
+type RevocationConfig struct {
+	CacheSize    int
+	SyncInterval time.Duration
+	SyncOverlap  time.Duration
+}
+
+// IntrospectionConfig controls the per-request IntrospectToken check against
+// the auth-service. Answers are cached for CacheTTL, so a deactivated or
+// locked user is rejected within that time.
+type IntrospectionConfig struct {
+	Enabled   bool
+	CacheTTL  time.Duration
+	CacheSize int
+}
+
+// ServiceAuthConfig holds the machine-client credentials this service uses
+// to call other services on its own behalf (client_credentials grant). The
+// token is fetched again RefreshBefore its expiry.
+type ServiceAuthConfig struct {
+	ClientID      string
+	ClientSecret  string
+	Scope         string
+	RefreshBefore time.Duration
 }
 
 func Load() (*Config, error) {
@@ -71,6 +117,30 @@ func Load() (*Config, error) {
 		},
 		Services: ServicesConfig{
 			UserServiceAddr: getEnv("USER_SERVICE_ADDR", "user-service:9003"),
+			AuthServiceAddr: getEnv("AUTH_SERVICE_ADDR", "auth-service:9002"),
+			TLSEnabled:      parseBool(getEnv("SERVICES_TLS_ENABLED", "false")),
+			TLSCAFile:       getEnv("SERVICES_TLS_CA_FILE", ""),
+		},
+		JWT: JWTConfig{
+			Issuer:              getEnv("JWT_ISSUER", "auth-service"),
+			ClockSkew:           parseDuration(getEnv("JWT_CLOCK_SKEW", "30s")),
+			JWKSRefreshInterval: parseDuration(getEnv("JWKS_REFRESH_INTERVAL", "5m")),
+		},
+		Revocation: RevocationConfig{
+			CacheSize:    parseInt(getEnv("REVOCATION_CACHE_SIZE", "100000")),
+			SyncInterval: parseDuration(getEnv("REVOCATION_SYNC_INTERVAL", "5s")),
+			SyncOverlap:  parseDuration(getEnv("REVOCATION_SYNC_OVERLAP", "30s")),
+		},
+		Introspection: IntrospectionConfig{
+			Enabled:   parseBool(getEnv("INTROSPECTION_ENABLED", "true")),
+			CacheTTL:  parseDuration(getEnv("INTROSPECTION_CACHE_TTL", "30s")),
+			CacheSize: parseInt(getEnv("INTROSPECTION_CACHE_SIZE", "10000")),
+		},
+		ServiceAuth: ServiceAuthConfig{
+			ClientID:      getEnv("SERVICE_CLIENT_ID", ""),
+			ClientSecret:  getEnv("SERVICE_CLIENT_SECRET", ""),
+			Scope:         getEnv("SERVICE_TOKEN_SCOPE", "users.read tokens.introspect"),
+			RefreshBefore: parseDuration(getEnv("SERVICE_TOKEN_REFRESH_BEFORE", "30s")),
 		},
 	}
 
@@ -85,6 +155,35 @@ func (c *Config) Validate() error {
 	if c.Database.Password == "" {
 		return fmt.Errorf("DB_PASSWORD is required")
 	}
+	if c.JWT.ClockSkew < 0 {
+		return fmt.Errorf("JWT_CLOCK_SKEW must not be negative")
+	}
+	if c.JWT.JWKSRefreshInterval <= 0 {
+		return fmt.Errorf("JWKS_REFRESH_INTERVAL must be positive")
+	}
+	if c.Revocation.CacheSize <= 0 {
+		return fmt.Errorf("REVOCATION_CACHE_SIZE must be positive")
+	}
+	if c.Revocation.SyncInterval <= 0 {
+		return fmt.Errorf("REVOCATION_SYNC_INTERVAL must be positive")
+	}
+	if c.Introspection.Enabled {
+		if c.Introspection.CacheTTL <= 0 {
+			return fmt.Errorf("INTROSPECTION_CACHE_TTL must be positive")
+		}
+		if c.Introspection.CacheSize <= 0 {
+			return fmt.Errorf("INTROSPECTION_CACHE_SIZE must be positive")
+		}
+	}
+	if c.Services.TLSCAFile != "" && !c.Services.TLSEnabled {
+		return fmt.Errorf("SERVICES_TLS_CA_FILE requires SERVICES_TLS_ENABLED")
+	}
+	if c.ServiceAuth.ClientID == "" || c.ServiceAuth.ClientSecret == "" {
+		return fmt.Errorf("SERVICE_CLIENT_ID and SERVICE_CLIENT_SECRET are required")
+	}
+	if c.ServiceAuth.RefreshBefore < 0 {
+		return fmt.Errorf("SERVICE_TOKEN_REFRESH_BEFORE must not be negative")
+	}
 	return nil
 }
 
@@ -107,6 +206,11 @@ func parseInt(s string) int {
 	return i
 }
 
+func parseBool(s string) bool {
+	b, _ := strconv.ParseBool(s)
+	return b
+}
+
 func parseDuration(s string) time.Duration {
 	d, _ := time.ParseDuration(s)
 	return d
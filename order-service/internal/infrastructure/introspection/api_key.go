@@ -0,0 +1,25 @@
+package introspection
+
+// APIKeyResult is the auth-service's answer for one personal API key. When
+// Active is false the other fields are empty.
+//
+// API key answers are not cached: the auth-service records every use of a
+// key, so each request resolves it again.
+type APIKeyResult struct {
+	Active bool
+	KeyID  string
+	UserID string
+	Email  string
+	Role   string
+	Scopes []string
+}
+
+// HasScope reports whether the key was created with scope.
+func (r *APIKeyResult) HasScope(scope string) bool {
+	for _, s := range r.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
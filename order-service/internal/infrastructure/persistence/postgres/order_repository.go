@@ -134,6 +134,22 @@ func (r *OrderRepository) List(ctx context.Context, limit, offset int) ([]*entit
 	return orders, total, nil
 }
 
+func (r *OrderRepository) AnonymizeByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&OrderModel{}).
+		Where("user_id = ? AND (shipping_address <> '' OR shipping_city <> '' OR shipping_postal_code <> '')", userID).
+		Updates(map[string]interface{}{
+			"shipping_address":     "",
+			"shipping_city":        "",
+			"shipping_postal_code": "",
+			"updated_at":           time.Now(),
+		})
+	if result.Error != nil {
+		return 0, domainErr.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
 func (r *OrderRepository) toModel(order *entity.Order) *OrderModel {
 	items := make([]OrderItemModel, len(order.Items))
 	for i, item := range order.Items {
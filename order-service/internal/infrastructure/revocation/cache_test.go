@@ -0,0 +1,26 @@
+package revocation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRevocationCoversEveryTokenOfTheUser(t *testing.T) {
+	cache := NewCache(10)
+	cache.Add(UserKey("7c9e6679-7425-40de-944b-e07fc1f90ae7"), time.Now().Add(time.Minute))
+
+	require.True(t, cache.IsRevoked("any-token", "7c9e6679-7425-40de-944b-e07fc1f90ae7"))
+	require.False(t, cache.IsRevoked("any-token", "16fd2706-8baf-433b-82eb-8c7fada847da"))
+	// Service tokens act for no user.
+	require.False(t, cache.IsRevoked("any-token", ""))
+}
+
+func TestTokenRevocationCoversOnlyThatToken(t *testing.T) {
+	cache := NewCache(10)
+	cache.Add(HashToken("logged-out"), time.Now().Add(time.Minute))
+
+	require.True(t, cache.IsRevoked("logged-out", "7c9e6679-7425-40de-944b-e07fc1f90ae7"))
+	require.False(t, cache.IsRevoked("other", "7c9e6679-7425-40de-944b-e07fc1f90ae7"))
+}
@@ -0,0 +1,80 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Entry is one revoked access token.
+type Entry struct {
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+// Feed returns revocations recorded by the auth-service.
+type Feed interface {
+	// RevokedSince returns unexpired revocations recorded at or after since.
+	// next is where the following call continues; more reports that the page
+	// was full and another call should follow right away.
+	RevokedSince(ctx context.Context, since time.Time) (entries []Entry, next time.Time, more bool, err error)
+}
+
+// Syncer keeps a Cache up to date by polling a Feed.
+type Syncer struct {
+	cache   *Cache
+	feed    Feed
+	overlap time.Duration
+	since   time.Time
+}
+
+// NewSyncer creates a syncer whose first sync loads every unexpired
+// revocation. Later syncs re-read the last overlap of the feed, so entries
+// that were committed late are not missed.
+func NewSyncer(cache *Cache, feed Feed, overlap time.Duration) *Syncer {
+	return &Syncer{cache: cache, feed: feed, overlap: overlap}
+}
+
+func (s *Syncer) Sync(ctx context.Context) error {
+	s.cache.PurgeExpired()
+
+	for {
+		entries, next, more, err := s.feed.RevokedSince(ctx, s.since)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			s.cache.Add(entry.TokenHash, entry.ExpiresAt)
+		}
+
+		if !more {
+			s.since = next.Add(-s.overlap)
+			return nil
+		}
+		// A full page whose entries all share one timestamp cannot advance
+		// the cursor; pick up the rest on the next sync.
+		if !next.After(s.since) {
+			return nil
+		}
+		s.since = next
+	}
+}
+
+// Run syncs every interval until ctx is cancelled.
+func (s *Syncer) Run(ctx context.Context, interval time.Duration, log *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.Sync(ctx); err != nil && ctx.Err() == nil {
+			log.Error("failed to sync revoked tokens", zap.Error(err))
+		}
+	}
+}
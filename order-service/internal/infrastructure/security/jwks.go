@@ -0,0 +1,191 @@
+package security
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// minRefreshInterval limits refreshes triggered by tokens with an unknown
+	// kid, so garbage tokens cannot make every request call the auth-service.
+	minRefreshInterval = 10 * time.Second
+	refreshTimeout     = 5 * time.Second
+)
+
+var errUnknownKey = errors.New("unknown signing key")
+
+// JSONWebKey is a public key as published by the auth-service JWKS.
+type JSONWebKey struct {
+	KeyID     string
+	KeyType   string
+	Algorithm string
+	Curve     string
+	N         string
+	E         string
+	X         string
+	Y         string
+}
+
+// KeySource fetches the JWKS; client.AuthClient implements it with the
+// auth-service GetJWKS RPC.
+type KeySource interface {
+	FetchJWKS(ctx context.Context) ([]JSONWebKey, error)
+}
+
+// KeySet holds the auth-service signing keys by kid. The auth-service
+// publishes the next key before it starts signing with it, so a periodic
+// refresh keeps up with rotation; a token with an unknown kid also triggers
+// a refresh, which covers startup before the auth-service was reachable.
+type KeySet struct {
+	source KeySource
+
+	mu   sync.RWMutex
+	keys map[string]verificationKey
+
+	refreshMu   sync.Mutex
+	lastAttempt time.Time
+}
+
+type verificationKey struct {
+	algorithm string
+	publicKey crypto.PublicKey
+}
+
+func NewKeySet(source KeySource) *KeySet {
+	return &KeySet{
+		source: source,
+		keys:   make(map[string]verificationKey),
+	}
+}
+
+// Refresh replaces the keys with the current JWKS. Keys of unsupported types
+// are skipped.
+func (s *KeySet) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	return s.refreshLocked(ctx)
+}
+
+// Run refreshes the keys every interval until ctx is cancelled.
+func (s *KeySet) Run(ctx context.Context, interval time.Duration, log *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil && ctx.Err() == nil {
+				log.Warn("failed to refresh JWKS", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *KeySet) lookup(kid string) (verificationKey, error) {
+	if key, ok := s.get(kid); ok {
+		return key, nil
+	}
+
+	s.refreshMu.Lock()
+	if time.Since(s.lastAttempt) >= minRefreshInterval {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		_ = s.refreshLocked(ctx)
+		cancel()
+	}
+	s.refreshMu.Unlock()
+
+	if key, ok := s.get(kid); ok {
+		return key, nil
+	}
+	return verificationKey{}, errUnknownKey
+}
+
+func (s *KeySet) get(kid string) (verificationKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func (s *KeySet) refreshLocked(ctx context.Context) error {
+	s.lastAttempt = time.Now()
+
+	jwks, err := s.source.FetchJWKS(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]verificationKey, len(jwks))
+	for _, jwk := range jwks {
+		publicKey, err := parseJWK(jwk)
+		if err != nil || jwk.KeyID == "" {
+			continue
+		}
+		keys[jwk.KeyID] = verificationKey{algorithm: jwk.Algorithm, publicKey: publicKey}
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+	return nil
+}
+
+func parseJWK(jwk JSONWebKey) (crypto.PublicKey, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if jwk.Curve != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("point is not on curve")
+		}
+		return key, nil
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key length")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.KeyType)
+	}
+}
@@ -0,0 +1,184 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "order-service/gen/go"
+)
+
+const address = "localhost:9004"
+
+// gatewayURL is where users register and log in; tokens come from the real
+// auth-service.
+func gatewayURL() string {
+	if v := os.Getenv("GATEWAY_URL"); v != "" {
+		return v
+	}
+	return "http://localhost:8000"
+}
+
+func newTestClient(t *testing.T) pb.OrderServiceClient {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Skip("Skipping integration test: server not reachable")
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	client := pb.NewOrderServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := client.HealthCheck(ctx, &pb.HealthCheckRequest{}); err != nil {
+		t.Skipf("Skipping integration test: server not reachable: %v", err)
+	}
+	return client
+}
+
+func postJSON(t *testing.T, path string, body, out any) {
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	resp, err := http.Post(gatewayURL()+path, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		t.Skipf("Skipping integration test: gateway not reachable: %v", err)
+	}
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode, "POST %s", path)
+	if out != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+}
+
+func registerAndLogin(t *testing.T, name string) string {
+	email := name + "_" + time.Now().Format("20060102150405.000000") + "@example.com"
+	credentials := map[string]string{"email": email, "password": "StrongPass123!"}
+
+	postJSON(t, "/api/v1/auth/register", credentials, nil)
+
+	var login struct {
+		AccessToken string `json:"access_token"`
+	}
+	postJSON(t, "/api/v1/auth/login", credentials, &login)
+	require.NotEmpty(t, login.AccessToken)
+	return login.AccessToken
+}
+
+// authedContext mimics a request forwarded by Kong, including the shared
+// consumer headers that must not be used as the caller's identity.
+func authedContext(accessToken string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	md := metadata.Pairs(
+		"x-consumer-id", "integration-test",
+		"x-consumer-custom-id", "authenticated_user",
+		"x-consumer-username", "authenticated_user",
+		"authorization", "Bearer "+accessToken,
+	)
+	return metadata.NewOutgoingContext(ctx, md), cancel
+}
+
+func createOrder(t *testing.T, client pb.OrderServiceClient, accessToken string) string {
+	ctx, cancel := authedContext(accessToken)
+	defer cancel()
+
+	resp, err := client.CreateOrder(ctx, &pb.CreateOrderRequest{
+		Items: []*pb.OrderItem{
+			{ProductId: "sku-1", ProductName: "Widget", Quantity: 1, Price: 9.99},
+		},
+		ShippingAddress:    "1 Main St",
+		ShippingCity:       "Hanoi",
+		ShippingCountry:    "VN",
+		ShippingPostalCode: "100000",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.OrderId)
+	return resp.OrderId
+}
+
+func listOrderIDs(t *testing.T, client pb.OrderServiceClient, accessToken string) map[string]string {
+	ctx, cancel := authedContext(accessToken)
+	defer cancel()
+
+	resp, err := client.ListOrders(ctx, &pb.ListOrdersRequest{Page: 1, PageSize: 100})
+	require.NoError(t, err)
+
+	owners := make(map[string]string, len(resp.Orders))
+	for _, order := range resp.Orders {
+		owners[order.OrderId] = order.UserId
+	}
+	return owners
+}
+
+func TestUsersCannotSeeEachOthersOrders(t *testing.T) {
+	client := newTestClient(t)
+	alice := registerAndLogin(t, "alice")
+	bob := registerAndLogin(t, "bob")
+
+	aliceOrder := createOrder(t, client, alice)
+	bobOrder := createOrder(t, client, bob)
+
+	aliceOrders := listOrderIDs(t, client, alice)
+	bobOrders := listOrderIDs(t, client, bob)
+	require.Contains(t, aliceOrders, aliceOrder)
+	require.NotContains(t, aliceOrders, bobOrder)
+	require.Contains(t, bobOrders, bobOrder)
+	require.NotContains(t, bobOrders, aliceOrder)
+
+	// Both went through the same Kong consumer but belong to different users.
+	require.NotEqual(t, aliceOrders[aliceOrder], bobOrders[bobOrder])
+
+	ctx, cancel := authedContext(alice)
+	defer cancel()
+	order, err := client.GetOrder(ctx, &pb.GetOrderRequest{OrderId: aliceOrder})
+	require.NoError(t, err)
+	require.Equal(t, aliceOrders[aliceOrder], order.UserId)
+
+	_, err = client.GetOrder(ctx, &pb.GetOrderRequest{OrderId: bobOrder})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUserCannotUpdateAnotherUsersOrder(t *testing.T) {
+	client := newTestClient(t)
+	alice := registerAndLogin(t, "alice")
+	bob := registerAndLogin(t, "bob")
+
+	aliceOrder := createOrder(t, client, alice)
+
+	ctx, cancel := authedContext(bob)
+	defer cancel()
+	_, err := client.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: aliceOrder, Status: "cancelled"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	ctx, cancel = authedContext(alice)
+	defer cancel()
+	order, err := client.GetOrder(ctx, &pb.GetOrderRequest{OrderId: aliceOrder})
+	require.NoError(t, err)
+	require.Equal(t, "pending", order.Status)
+}
+
+func TestForgedConsumerHeadersWithoutTokenAreRejected(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		"x-consumer-id", "integration-test",
+		"x-consumer-custom-id", "00000000-0000-0000-0000-000000000001",
+	))
+
+	_, err := client.ListOrders(ctx, &pb.ListOrdersRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,23 @@
+#!/bin/bash
+
+# Generate .env with fresh secrets for docker-compose
+
+set -e
+
+ROOT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+ENV_FILE="$ROOT_DIR/.env"
+
+if [ -f "$ENV_FILE" ]; then
+    echo ".env already exists; remove it first to generate new secrets."
+    exit 1
+fi
+
+cat > "$ENV_FILE" <<ENV
+ENCRYPTION_KEY=$(openssl rand -base64 32)
+ORDER_SERVICE_CLIENT_ID=$(cat /proc/sys/kernel/random/uuid 2>/dev/null || uuidgen | tr 'A-Z' 'a-z')
+ORDER_SERVICE_CLIENT_SECRET=$(openssl rand -hex 32)
+USER_SERVICE_CLIENT_ID=$(cat /proc/sys/kernel/random/uuid 2>/dev/null || uuidgen | tr 'A-Z' 'a-z')
+USER_SERVICE_CLIENT_SECRET=$(openssl rand -hex 32)
+ENV
+
+echo "Wrote $ENV_FILE"
@@ -13,9 +13,14 @@ import (
 	"user-service/internal/application/usecase"
 	grpcHandler "user-service/internal/delivery/grpc/handler"
 	"user-service/internal/delivery/grpc/interceptor"
+	"user-service/internal/infrastructure/client"
 	"user-service/internal/infrastructure/config"
+	"user-service/internal/infrastructure/introspection"
 	"user-service/internal/infrastructure/logger"
 	"user-service/internal/infrastructure/persistence/postgres"
+	"user-service/internal/infrastructure/revocation"
+	"user-service/internal/infrastructure/security"
+	"user-service/internal/infrastructure/servicetoken"
 	"user-service/internal/infrastructure/telemetry"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -48,6 +53,54 @@ func main() {
 
 	profileRepo := postgres.NewUserProfileRepository(db)
 
+	// Initialize auth-service client
+	authClient, err := client.NewAuthClient(&cfg.Services)
+	if err != nil {
+		log.Error("failed to initialize auth client", zap.Error(err))
+		panic(err)
+	}
+	defer authClient.Close()
+
+	// This service's own token, for the auth-service introspection RPCs
+	authClient.UseServiceTokens(servicetoken.NewSource(
+		authClient,
+		cfg.ServiceAuth.ClientID,
+		cfg.ServiceAuth.ClientSecret,
+		cfg.ServiceAuth.Scope,
+		cfg.ServiceAuth.RefreshBefore,
+	))
+
+	// Background refreshes of signing keys and revoked tokens
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// --- Token Verification ---
+	signingKeys := security.NewKeySet(authClient)
+	if err := signingKeys.Refresh(context.Background()); err != nil {
+		// The auth-service may still be starting; tokens with an unknown kid
+		// trigger another fetch.
+		log.Warn("failed to load JWKS", zap.Error(err))
+	}
+	go signingKeys.Run(backgroundCtx, cfg.JWT.JWKSRefreshInterval, log.Logger)
+	tokenVerifier := security.NewTokenVerifier(signingKeys, cfg.JWT.Issuer, cfg.JWT.ClockSkew)
+
+	// --- Token Revocation ---
+	revokedTokens := revocation.NewCache(cfg.Revocation.CacheSize)
+	revocationSyncer := revocation.NewSyncer(revokedTokens, authClient, cfg.Revocation.SyncOverlap)
+	if err := revocationSyncer.Sync(context.Background()); err != nil {
+		// The auth-service may still be starting; the syncer retries.
+		log.Warn("failed to load revoked tokens", zap.Error(err))
+	}
+	go revocationSyncer.Run(backgroundCtx, cfg.Revocation.SyncInterval, log.Logger)
+
+	// --- Token Introspection ---
+	var introspector interceptor.TokenIntrospector
+	if cfg.Introspection.Enabled {
+		introspector = introspection.NewClient(authClient, cfg.Introspection.CacheTTL, cfg.Introspection.CacheSize)
+	} else {
+		log.Warn("token introspection disabled; deactivated and locked users keep access until their tokens expire")
+	}
+
 	// --- Telemetry Initialization ---
 	shutdownTelemetry, err := telemetry.Init("user-service", cfg.Telemetry.CollectorAddr)
 	if err != nil {
@@ -75,7 +128,7 @@ func main() {
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor()),
+		grpc.UnaryInterceptor(interceptor.NewAuthInterceptor(tokenVerifier, revokedTokens, introspector, authClient)),
 	)
 	proto.RegisterUserServiceServer(grpcServer, grpcHandler)
 
@@ -22,7 +22,10 @@ func NewUserUseCase(profileRepo repository.UserProfileRepository) *UserUseCase {
 	}
 }
 
-func (uc *UserUseCase) GetProfile(ctx context.Context, userID string) (*dto.UserProfileDTO, error) {
+// GetProfile returns the caller's profile, creating an empty one on first
+// use. email is the one on the caller's token; it is stored on a new profile
+// and shown for profiles created before emails were kept.
+func (uc *UserUseCase) GetProfile(ctx context.Context, userID, email string) (*dto.UserProfileDTO, error) {
 	log.Printf("Getting profile for user ID: %s", userID)
 	userUUID, err := uuid.Parse(userID)
 	log.Printf("Parsed User UUID: %+v", userUUID)
@@ -45,6 +48,7 @@ func (uc *UserUseCase) GetProfile(ctx context.Context, userID string) (*dto.User
 				"", // Country
 				"", // PostalCode
 			)
+			profile.Email = email
 			if err := uc.profileRepo.Create(ctx, profile); err != nil {
 				log.Printf("Error creating default profile: %v", err)
 				return nil, err
@@ -55,9 +59,13 @@ func (uc *UserUseCase) GetProfile(ctx context.Context, userID string) (*dto.User
 			return nil, err
 		}
 	}
+	if profile.Email != "" {
+		email = profile.Email
+	}
 
 	return &dto.UserProfileDTO{
 		UserID:     profile.UserID.String(),
+		Email:      email,
 		FirstName:  profile.FirstName,
 		LastName:   profile.LastName,
 		Phone:      profile.Phone,
@@ -121,6 +129,7 @@ func (uc *UserUseCase) GetUser(ctx context.Context, userID string) (*dto.UserPro
 
 	return &dto.UserProfileDTO{
 		UserID:     profile.UserID.String(),
+		Email:      profile.Email,
 		FirstName:  profile.FirstName,
 		LastName:   profile.LastName,
 		Phone:      profile.Phone,
@@ -156,6 +165,7 @@ func (uc *UserUseCase) ListUsers(ctx context.Context, page, pageSize int32) (*dt
 	for i, profile := range profiles {
 		users[i] = dto.UserInfoDTO{
 			UserID:    profile.UserID.String(),
+			Email:     profile.Email,
 			FirstName: profile.FirstName,
 			LastName:  profile.LastName,
 			Phone:     profile.Phone,
@@ -171,3 +181,34 @@ func (uc *UserUseCase) ListUsers(ctx context.Context, page, pageSize int32) (*dt
 	}, nil
 }
 
+// DeleteUserData erases the user's profile after the account was deleted in
+// the auth-service. It is idempotent, so the auth-service can retry it.
+func (uc *UserUseCase) DeleteUserData(ctx context.Context, userID string) (bool, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return false, domainErr.ErrInvalidInput
+	}
+
+	return uc.profileRepo.DeleteByUserID(ctx, userUUID)
+}
+
+// ExportUserData returns the user's profile for a personal data export
+// requested through the auth-service, or nil if the user has none.
+func (uc *UserUseCase) ExportUserData(ctx context.Context, userID string) (*dto.UserProfileDTO, error) {
+	profile, err := uc.GetUser(ctx, userID)
+	if err == domainErr.ErrProfileNotFound {
+		return nil, nil
+	}
+	return profile, err
+}
+
+// SyncUserEmail stores the email a user changed to in the auth-service. It
+// reports false if the user has no profile yet.
+func (uc *UserUseCase) SyncUserEmail(ctx context.Context, userID, email string) (bool, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil || email == "" {
+		return false, domainErr.ErrInvalidInput
+	}
+
+	return uc.profileRepo.UpdateEmail(ctx, userUUID, email)
+}
@@ -32,19 +32,17 @@ func (h *GRPCHandler) GetProfile(ctx context.Context, req *proto.GetProfileReque
 		return nil, err
 	}
 
-	profile, err := h.userUsecase.GetProfile(ctx, userID)
+	email := interceptor.GetUserEmailFromContext(ctx)
+
+	profile, err := h.userUsecase.GetProfile(ctx, userID, email)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 	log.Printf("Profile: %+v", profile)
 
-	email := interceptor.GetUserEmailFromContext(ctx)
-
-	log.Printf("Email: %s", email)
-
 	return &proto.GetProfileResponse{
 		UserId:     profile.UserID,
-		Email:      email,
+		Email:      profile.Email,
 		FirstName:  profile.FirstName,
 		LastName:   profile.LastName,
 		Phone:      profile.Phone,
@@ -135,3 +133,67 @@ func (h *GRPCHandler) ListUsers(ctx context.Context, req *proto.ListUsersRequest
 	}, nil
 }
 
+// DeleteUserData is called by the auth-service, as a service principal, when
+// an account is deleted.
+func (h *GRPCHandler) DeleteUserData(ctx context.Context, req *proto.DeleteUserDataRequest) (*proto.DeleteUserDataResponse, error) {
+	serviceID, err := interceptor.RequireServiceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := h.userUsecase.DeleteUserData(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	log.Printf("User data deleted for user %s by %s", req.GetUserId(), serviceID)
+	return &proto.DeleteUserDataResponse{Deleted: deleted}, nil
+}
+
+// ExportUserData is called by the auth-service, as a service principal, when
+// a user exports their personal data.
+func (h *GRPCHandler) ExportUserData(ctx context.Context, req *proto.ExportUserDataRequest) (*proto.ExportUserDataResponse, error) {
+	serviceID, err := interceptor.RequireServiceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.userUsecase.ExportUserData(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	log.Printf("User data exported for user %s by %s", req.GetUserId(), serviceID)
+	if profile == nil {
+		return &proto.ExportUserDataResponse{}, nil
+	}
+	return &proto.ExportUserDataResponse{
+		Found:      true,
+		FirstName:  profile.FirstName,
+		LastName:   profile.LastName,
+		Phone:      profile.Phone,
+		Address:    profile.Address,
+		City:       profile.City,
+		Country:    profile.Country,
+		PostalCode: profile.PostalCode,
+		CreatedAt:  profile.CreatedAt,
+		UpdatedAt:  profile.UpdatedAt,
+	}, nil
+}
+
+// SyncUserEmail is called by the auth-service, as a service principal, after
+// a user changes their email.
+func (h *GRPCHandler) SyncUserEmail(ctx context.Context, req *proto.SyncUserEmailRequest) (*proto.SyncUserEmailResponse, error) {
+	serviceID, err := interceptor.RequireServiceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.userUsecase.SyncUserEmail(ctx, req.GetUserId(), req.GetEmail())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	log.Printf("Email synced for user %s by %s", req.GetUserId(), serviceID)
+	return &proto.SyncUserEmailResponse{Updated: updated}, nil
+}
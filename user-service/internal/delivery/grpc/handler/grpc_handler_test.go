@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	proto "user-service/gen/go"
+	"user-service/internal/application/usecase"
+	"user-service/internal/delivery/grpc/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userContext is the context the auth interceptor builds for a user token.
+func userContext() context.Context {
+	ctx := context.WithValue(context.Background(), interceptor.UserIDKey, "7c9e6679-7425-40de-944b-e07fc1f90ae7")
+	return context.WithValue(ctx, interceptor.UserRoleKey, "admin")
+}
+
+func TestUserCannotExportAnotherUsersData(t *testing.T) {
+	h := NewGRPCHandler(usecase.UserUseCase{})
+
+	_, err := h.ExportUserData(userContext(), &proto.ExportUserDataRequest{UserId: "0b6a4a3e-9f1e-4c1b-8f0e-2d3c4b5a6978"})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Fatalf("got %s, want PermissionDenied", code)
+	}
+}
+
+func TestUserCannotSyncAnotherUsersEmail(t *testing.T) {
+	h := NewGRPCHandler(usecase.UserUseCase{})
+
+	_, err := h.SyncUserEmail(userContext(), &proto.SyncUserEmailRequest{UserId: "0b6a4a3e-9f1e-4c1b-8f0e-2d3c4b5a6978", Email: "attacker@example.com"})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Fatalf("got %s, want PermissionDenied", code)
+	}
+}
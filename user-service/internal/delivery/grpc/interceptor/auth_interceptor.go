@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"user-service/internal/infrastructure/introspection"
 	"user-service/internal/infrastructure/security"
 
 	"google.golang.org/grpc"
@@ -22,13 +23,63 @@ const (
 	ClientIPKey    contextKey = "client_ip"
 	UserAgentKey   contextKey = "user_agent"
 	AccessTokenKey contextKey = "access_token"
+	ServiceIDKey   contextKey = "service_client_id"
 )
 
 var publicMethods = map[string]bool{
 	"/proto.UserService/HealthCheck": true,
 }
 
-func NewAuthInterceptor() grpc.UnaryServerInterceptor {
+// serviceMethods are the internal RPCs other services may call with a
+// client_credentials token, and the scope each one requires. Service
+// principals cannot call any other method, and no one else can call these.
+var serviceMethods = map[string]string{
+	"/proto.UserService/GetUser":        "users.read",
+	"/proto.UserService/DeleteUserData": "users.delete",
+	"/proto.UserService/ExportUserData": "users.export",
+	"/proto.UserService/SyncUserEmail":  "users.sync",
+}
+
+// apiKeyMethods are the RPCs personal API keys may call and the scope each
+// one requires. Every other method needs an access token.
+var apiKeyMethods = map[string]string{
+	"/proto.UserService/GetProfile":    "profile.read",
+	"/proto.UserService/UpdateProfile": "profile.write",
+}
+
+// RevocationChecker reports whether an access token was revoked, e.g. by
+// logout, or every token of its user was, as when the account is deleted.
+// It must not call out per request; see the revocation package.
+type RevocationChecker interface {
+	IsRevoked(token, userID string) bool
+}
+
+// TokenIntrospector asks the auth-service whether a token is still active,
+// which also covers deactivated and locked users. Implementations should
+// cache; see the introspection package.
+type TokenIntrospector interface {
+	Introspect(ctx context.Context, token string) (*introspection.Result, error)
+}
+
+// APIKeyIntrospector resolves a personal API key to its owner and scopes
+// through the auth-service, which records the use; client.AuthClient
+// implements it.
+type APIKeyIntrospector interface {
+	IntrospectAPIKey(ctx context.Context, key string) (*introspection.APIKeyResult, error)
+}
+
+// TokenVerifier checks an access token's signature and its exp, nbf and iss
+// claims; see security.TokenVerifier.
+type TokenVerifier interface {
+	Verify(token string) (*security.Claims, error)
+}
+
+// NewAuthInterceptor authenticates requests by their bearer token, which is
+// verified here rather than trusted because Kong forwarded it; the service is
+// safe to call around the gateway. If introspector is nil, introspection is
+// skipped and the identity is taken from the verified claims. Requests with
+// "Authorization: ApiKey <key>" are resolved through apiKeys instead.
+func NewAuthInterceptor(verifier TokenVerifier, revocations RevocationChecker, introspector TokenIntrospector, apiKeys APIKeyIntrospector) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -48,39 +99,123 @@ func NewAuthInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		// Check if request was authenticated by Kong Gateway
-		if kongConsumerID := md.Get("x-consumer-id"); len(kongConsumerID) > 0 {
-			log.Println("✅ Request authenticated by Kong Gateway, consumer:", kongConsumerID[0])
-
-			// Extract JWT token and parse claims (Kong already validated it)
-			authHeaders := md.Get("authorization")
-			if len(authHeaders) > 0 {
-				parts := strings.SplitN(authHeaders[0], " ", 2)
-				if len(parts) == 2 {
-					token := parts[1]
-					// Parse JWT token to extract user info directly from claims
-					claims, err := security.ExtractClaimsWithoutValidation(token)
-					if err == nil {
-						ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-						ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
-						ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
-						ctx = context.WithValue(ctx, AccessTokenKey, token)
-						log.Printf("📋 Extracted user info from JWT - UserID: %s, Email: %s, Role: %s", claims.UserID, claims.Email, claims.Role)
-						return handler(ctx, req)
-					}
-					log.Printf("⚠️  Failed to extract claims from JWT: %v", err)
-				}
-			}
+		if key := apiKey(md); key != "" {
+			return authorizeAPIKey(ctx, req, info, handler, apiKeys, key)
+		}
+
+		token := bearerToken(md)
+		if token == "" {
+			log.Println("❌ Request without bearer token")
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
 
-			return nil, status.Error(codes.Internal, "failed to extract user info from JWT")
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			log.Printf("⚠️  Rejected access token: %v", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		// Kong cannot see revocations, so logged-out tokens are rejected here.
+		if revocations.IsRevoked(token, claims.UserID) {
+			return nil, status.Error(codes.Unauthenticated, "token has been revoked")
 		}
 
-		// If no Kong consumer header, request is unauthenticated
-		log.Println("❌ Request not authenticated by Kong Gateway")
-		return nil, status.Error(codes.Unauthenticated, "unauthorized: request must go through API gateway")
+		ctx = context.WithValue(ctx, AccessTokenKey, token)
+		if claims.IsServicePrincipal() {
+			return authorizeService(ctx, req, info, handler, claims)
+		}
+		if _, ok := serviceMethods[info.FullMethod]; ok {
+			return nil, status.Error(codes.PermissionDenied, "method is only available to service clients")
+		}
+		if claims.IsDelegated() {
+			return nil, status.Error(codes.PermissionDenied, "method is not available to oauth clients")
+		}
+		if introspector != nil {
+			result, err := introspector.Introspect(ctx, token)
+			if err != nil {
+				log.Printf("⚠️  Token introspection failed: %v", err)
+				return nil, status.Error(codes.Unavailable, "token introspection unavailable")
+			}
+			if !result.Active {
+				return nil, status.Error(codes.Unauthenticated, "token is not active")
+			}
+			ctx = context.WithValue(ctx, UserIDKey, result.UserID)
+			ctx = context.WithValue(ctx, UserEmailKey, result.Email)
+			ctx = context.WithValue(ctx, UserRoleKey, result.Role)
+			return handler(ctx, req)
+		}
+
+		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
+		ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+		return handler(ctx, req)
 	}
 }
 
+// authorizeService admits a machine client to the internal RPCs its scopes
+// allow. Introspection is skipped: there is no user to check, and service
+// tokens are short-lived, so a deleted client loses access once its token
+// expires.
+func authorizeService(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, claims *security.Claims) (interface{}, error) {
+	scope, ok := serviceMethods[info.FullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method is not available to service clients")
+	}
+	if !claims.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "token lacks the %s scope", scope)
+	}
+
+	ctx = context.WithValue(ctx, ServiceIDKey, claims.ClientID)
+	return handler(ctx, req)
+}
+
+// authorizeAPIKey identifies the caller as the owner of a personal API key,
+// provided the method is open to API keys and the key has its scope.
+func authorizeAPIKey(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler, apiKeys APIKeyIntrospector, key string) (interface{}, error) {
+	scope, ok := apiKeyMethods[info.FullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "method cannot be called with an api key")
+	}
+
+	result, err := apiKeys.IntrospectAPIKey(ctx, key)
+	if err != nil {
+		log.Printf("⚠️  API key introspection failed: %v", err)
+		return nil, status.Error(codes.Unavailable, "api key introspection unavailable")
+	}
+	if !result.Active {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	if !result.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key lacks the %s scope", scope)
+	}
+
+	ctx = context.WithValue(ctx, UserIDKey, result.UserID)
+	ctx = context.WithValue(ctx, UserEmailKey, result.Email)
+	ctx = context.WithValue(ctx, UserRoleKey, result.Role)
+	return handler(ctx, req)
+}
+
+func bearerToken(md metadata.MD) string {
+	return credentials(md, "Bearer")
+}
+
+func apiKey(md metadata.MD) string {
+	return credentials(md, "ApiKey")
+}
+
+// credentials returns the authorization header's credentials if it uses
+// scheme.
+func credentials(md metadata.MD, scheme string) string {
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return ""
+	}
+	parts := strings.SplitN(authHeaders[0], " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], scheme) {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
+
 func GetUserIDFromContext(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value(UserIDKey).(string)
 	if !ok || userID == "" {
@@ -101,6 +236,23 @@ func GetUserAgentFromContext(ctx context.Context) string {
 	return agent
 }
 
+// GetServiceIDFromContext returns the client ID of the calling service, or
+// "" if the caller is a user.
+func GetServiceIDFromContext(ctx context.Context) string {
+	clientID, _ := ctx.Value(ServiceIDKey).(string)
+	return clientID
+}
+
+// RequireServiceFromContext returns the client ID of the calling service and
+// denies callers that are not service principals.
+func RequireServiceFromContext(ctx context.Context) (string, error) {
+	clientID := GetServiceIDFromContext(ctx)
+	if clientID == "" {
+		return "", status.Error(codes.PermissionDenied, "method is only available to service clients")
+	}
+	return clientID, nil
+}
+
 func GetAccessTokenFromContext(ctx context.Context) string {
 	token, _ := ctx.Value(AccessTokenKey).(string)
 	return token
@@ -0,0 +1,105 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"user-service/internal/infrastructure/introspection"
+	"user-service/internal/infrastructure/security"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubVerifier struct {
+	claims *security.Claims
+}
+
+func (v stubVerifier) Verify(token string) (*security.Claims, error) {
+	if token != "valid" {
+		return nil, errors.New("invalid token")
+	}
+	return v.claims, nil
+}
+
+type noRevocations struct{}
+
+func (noRevocations) IsRevoked(string, string) bool { return false }
+
+// allScopesAPIKeys accepts every key as one carrying all known scopes.
+type allScopesAPIKeys struct{}
+
+func (allScopesAPIKeys) IntrospectAPIKey(ctx context.Context, key string) (*introspection.APIKeyResult, error) {
+	var scopes []string
+	for _, scope := range apiKeyMethods {
+		scopes = append(scopes, scope)
+	}
+	for _, scope := range serviceMethods {
+		scopes = append(scopes, scope)
+	}
+	return &introspection.APIKeyResult{Active: true, UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "admin", Scopes: scopes}, nil
+}
+
+func callAs(t *testing.T, claims *security.Claims, method string) error {
+	t.Helper()
+	return call(t, NewAuthInterceptor(stubVerifier{claims: claims}, noRevocations{}, nil, nil), "Bearer valid", method)
+}
+
+func callWithAPIKey(t *testing.T, method string) error {
+	t.Helper()
+	return call(t, NewAuthInterceptor(stubVerifier{}, noRevocations{}, nil, allScopesAPIKeys{}), "ApiKey ak_test", method)
+}
+
+func call(t *testing.T, intercept grpc.UnaryServerInterceptor, authorization, method string) error {
+	t.Helper()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authorization))
+	_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestUserTokenCannotCallServiceMethods(t *testing.T) {
+	claims := &security.Claims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "admin"}
+
+	for method := range serviceMethods {
+		if code := status.Code(callAs(t, claims, method)); code != codes.PermissionDenied {
+			t.Errorf("%s: got %s, want PermissionDenied", method, code)
+		}
+	}
+}
+
+func TestAPIKeyCannotCallServiceMethods(t *testing.T) {
+	for method := range serviceMethods {
+		if code := status.Code(callWithAPIKey(t, method)); code != codes.PermissionDenied {
+			t.Errorf("%s: got %s, want PermissionDenied", method, code)
+		}
+	}
+}
+
+func TestUserTokenCanCallProfileMethods(t *testing.T) {
+	claims := &security.Claims{UserID: "7c9e6679-7425-40de-944b-e07fc1f90ae7", Role: "user"}
+
+	for method := range apiKeyMethods {
+		if err := callAs(t, claims, method); err != nil {
+			t.Errorf("%s: %v", method, err)
+		}
+	}
+}
+
+func TestServiceTokenCanCallServiceMethods(t *testing.T) {
+	claims := &security.Claims{Role: security.ServiceRole, ClientID: "auth-service", Scope: "users.read users.delete users.export users.sync"}
+	claims.Subject = claims.ClientID
+
+	for method := range serviceMethods {
+		if err := callAs(t, claims, method); err != nil {
+			t.Errorf("%s: %v", method, err)
+		}
+	}
+	if code := status.Code(callAs(t, claims, "/proto.UserService/GetProfile")); code != codes.PermissionDenied {
+		t.Errorf("GetProfile: got %s, want PermissionDenied", code)
+	}
+}
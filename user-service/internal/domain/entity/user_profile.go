@@ -9,6 +9,9 @@ import (
 type UserProfile struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
+	// Email is a copy of the account's email in the auth-service, which
+	// keeps it current when the user changes it.
+	Email     string
 	FirstName string
 	LastName  string
 	Phone     string
@@ -14,5 +14,11 @@ type UserProfileRepository interface {
 	Update(ctx context.Context, profile *entity.UserProfile) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.UserProfile, error)
 	List(ctx context.Context, limit, offset int) ([]*entity.UserProfile, int64, error)
+	// DeleteByUserID permanently deletes the user's profile and reports
+	// whether there was one.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (bool, error)
+	// UpdateEmail sets the email of the user's profile and reports whether
+	// there was one.
+	UpdateEmail(ctx context.Context, userID uuid.UUID, email string) (bool, error)
 }
 
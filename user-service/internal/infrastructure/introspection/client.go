@@ -0,0 +1,109 @@
+package introspection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"user-service/internal/infrastructure/revocation"
+)
+
+// Result is the auth-service's answer for one access token. When Active is
+// false the other fields are empty.
+type Result struct {
+	Active    bool
+	UserID    string
+	Email     string
+	Role      string
+	SessionID string
+	ExpiresAt time.Time
+}
+
+// Source performs the IntrospectToken call; client.AuthClient implements it.
+type Source interface {
+	IntrospectToken(ctx context.Context, token string) (*Result, error)
+}
+
+// Client caches introspection results by token hash. Active results are kept
+// for ttl but never past the token's expiry; inactive results are kept for
+// ttl. Errors are not cached. The cache is bounded: when it is full and
+// nothing has expired, the entry that expires soonest is evicted.
+type Client struct {
+	source     Source
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.RWMutex
+	entries map[string]cachedResult
+}
+
+type cachedResult struct {
+	result    *Result
+	expiresAt time.Time
+}
+
+func NewClient(source Source, ttl time.Duration, maxEntries int) *Client {
+	return &Client{
+		source:     source,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]cachedResult),
+	}
+}
+
+func (c *Client) Introspect(ctx context.Context, token string) (*Result, error) {
+	key := revocation.HashToken(token)
+
+	c.mu.RLock()
+	cached, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.result, nil
+	}
+
+	result, err := c.source.IntrospectToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(c.ttl)
+	if result.Active && result.ExpiresAt.Before(expiresAt) {
+		expiresAt = result.ExpiresAt
+	}
+	c.store(key, cachedResult{result: result, expiresAt: expiresAt})
+
+	return result, nil
+}
+
+func (c *Client) store(key string, entry cachedResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.purgeExpiredLocked()
+		if len(c.entries) >= c.maxEntries {
+			c.evictSoonestLocked()
+		}
+	}
+	c.entries[key] = entry
+}
+
+func (c *Client) purgeExpiredLocked() {
+	now := time.Now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *Client) evictSoonestLocked() {
+	var soonestKey string
+	var soonest time.Time
+	for key, entry := range c.entries {
+		if soonestKey == "" || entry.expiresAt.Before(soonest) {
+			soonestKey, soonest = key, entry.expiresAt
+		}
+	}
+	delete(c.entries, soonestKey)
+}
@@ -15,6 +15,7 @@ import (
 type UserProfileModel struct {
 	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
 	UserID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	Email      string
 	FirstName  string
 	LastName   string
 	Phone      string
@@ -100,10 +101,30 @@ func (r *UserProfileRepository) List(ctx context.Context, limit, offset int) ([]
 	return profiles, total, nil
 }
 
+func (r *UserProfileRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&UserProfileModel{})
+	if result.Error != nil {
+		return false, domainErr.ErrDatabase
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *UserProfileRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, email string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&UserProfileModel{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{"email": email, "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, domainErr.ErrDatabase
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *UserProfileRepository) toModel(profile *entity.UserProfile) *UserProfileModel {
 	return &UserProfileModel{
 		ID:         profile.ID,
 		UserID:     profile.UserID,
+		Email:      profile.Email,
 		FirstName:  profile.FirstName,
 		LastName:   profile.LastName,
 		Phone:      profile.Phone,
@@ -120,6 +141,7 @@ func (r *UserProfileRepository) toEntity(model *UserProfileModel) *entity.UserPr
 	return &entity.UserProfile{
 		ID:         model.ID,
 		UserID:     model.UserID,
+		Email:      model.Email,
 		FirstName:  model.FirstName,
 		LastName:   model.LastName,
 		Phone:      model.Phone,
@@ -0,0 +1,104 @@
+package revocation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"sync"
+	"time"
+)
+
+// Cache holds the hashes of revoked access tokens until the tokens expire.
+// It is bounded: when it is full and nothing has expired, the entry that
+// expires soonest is evicted.
+type Cache struct {
+	mu         sync.RWMutex
+	entries    map[string]time.Time
+	maxEntries int
+}
+
+func NewCache(maxEntries int) *Cache {
+	return &Cache{
+		entries:    make(map[string]time.Time),
+		maxEntries: maxEntries,
+	}
+}
+
+// HashToken returns the key under which a token is revoked. It matches the
+// hash stored in the auth-service token_blacklist table.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return base64.URLEncoding.EncodeToString(hash[:])
+}
+
+// UserKey returns the key under which every access token of a user is
+// revoked, as when their account is deleted. It matches the auth-service's
+// entity.UserRevocationKey.
+func UserKey(userID string) string {
+	return "user:" + userID
+}
+
+// IsRevoked reports whether the token, or every token of userID, was
+// revoked. userID is empty for tokens that act for no user.
+func (c *Cache) IsRevoked(token, userID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.revokedLocked(HashToken(token)) {
+		return true
+	}
+	return userID != "" && c.revokedLocked(UserKey(userID))
+}
+
+func (c *Cache) revokedLocked(key string) bool {
+	expiresAt, ok := c.entries[key]
+	return ok && time.Now().Before(expiresAt)
+}
+
+func (c *Cache) Add(tokenHash string, expiresAt time.Time) {
+	if !time.Now().Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[tokenHash]; !ok && len(c.entries) >= c.maxEntries {
+		c.purgeExpiredLocked()
+		if len(c.entries) >= c.maxEntries {
+			c.evictSoonestLocked()
+		}
+	}
+	c.entries[tokenHash] = expiresAt
+}
+
+func (c *Cache) PurgeExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeExpiredLocked()
+}
+
+func (c *Cache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
+
+func (c *Cache) purgeExpiredLocked() {
+	now := time.Now()
+	for hash, expiresAt := range c.entries {
+		if !now.Before(expiresAt) {
+			delete(c.entries, hash)
+		}
+	}
+}
+
+func (c *Cache) evictSoonestLocked() {
+	var soonestHash string
+	var soonest time.Time
+	for hash, expiresAt := range c.entries {
+		if soonestHash == "" || expiresAt.Before(soonest) {
+			soonestHash, soonest = hash, expiresAt
+		}
+	}
+	delete(c.entries, soonestHash)
+}
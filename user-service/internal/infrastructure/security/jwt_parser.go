@@ -2,33 +2,92 @@ package security
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ServiceRole is the role claim of tokens the auth-service issues to machine
+// clients through the client_credentials grant.
+const ServiceRole = "service"
+
 // Claims represents the JWT claims structure
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// ExtractClaimsWithoutValidation extracts claims from JWT token without signature validation
-// This is safe to use when JWT is already validated by Kong Gateway
-func ExtractClaimsWithoutValidation(tokenString string) (*Claims, error) {
-	// Parse token without verification
-	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
-	token, _, err := parser.ParseUnverified(tokenString, &Claims{})
+// IsServicePrincipal reports whether the token was issued to a machine
+// client acting on its own behalf. Such tokens carry no user_id.
+func (c *Claims) IsServicePrincipal() bool {
+	return c.Role == ServiceRole && c.UserID == "" && c.ClientID != "" && c.Subject == c.ClientID
+}
+
+// IsDelegated reports whether the token was issued to an OAuth client
+// acting for a user. Such a token only carries the identity scopes the user
+// consented to, none of which covers this service's APIs.
+func (c *Claims) IsDelegated() bool {
+	return c.UserID != "" && c.ClientID != ""
+}
+
+// HasScope reports whether scope was granted to the token.
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range strings.Fields(c.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenVerifier checks access tokens issued by the auth-service: the
+// signature against the published key named by the kid header, and the exp,
+// nbf and iss claims. It does not rely on the gateway having checked them.
+type TokenVerifier struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+// NewTokenVerifier accepts tokens from issuer; clockSkew is the leeway
+// allowed on exp and nbf for clock drift between services.
+func NewTokenVerifier(keys *KeySet, issuer string, clockSkew time.Duration) *TokenVerifier {
+	return &TokenVerifier{
+		keys: keys,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+			jwt.WithIssuer(issuer),
+			jwt.WithExpirationRequired(),
+			jwt.WithLeeway(clockSkew),
+		),
+	}
+}
+
+func (v *TokenVerifier) Verify(tokenString string) (*Claims, error) {
+	token, err := v.parser.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := v.keys.lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.algorithm {
+			return nil, fmt.Errorf("token algorithm %s does not match key %s", token.Method.Alg(), kid)
+		}
+		return key.publicKey, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
+		return nil, fmt.Errorf("invalid token: %w", err)
 	}
 
 	claims, ok := token.Claims.(*Claims)
-	if !ok {
-		return nil, fmt.Errorf("invalid claims type")
+	if !ok || (claims.UserID == "" && !claims.IsServicePrincipal()) {
+		return nil, fmt.Errorf("invalid claims")
 	}
 
 	return claims, nil
 }
-
@@ -0,0 +1,99 @@
+package servicetoken
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Token is an access token issued to this service by the auth-service.
+type Token struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// Issuer performs the client_credentials grant; client.AuthClient implements
+// it with the auth-service OAuthToken RPC.
+type Issuer interface {
+	ClientCredentialsToken(ctx context.Context, clientID, clientSecret, scope string) (*Token, error)
+}
+
+// Source holds the service's own access token, which identifies it as a
+// machine client to other services. The token is fetched on first use and
+// again once it is within refreshBefore of expiring; concurrent callers share
+// one fetch.
+type Source struct {
+	issuer        Issuer
+	clientID      string
+	clientSecret  string
+	scope         string
+	refreshBefore time.Duration
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func NewSource(issuer Issuer, clientID, clientSecret, scope string, refreshBefore time.Duration) *Source {
+	return &Source{
+		issuer:        issuer,
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		scope:         scope,
+		refreshBefore: refreshBefore,
+	}
+}
+
+func (s *Source) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && time.Until(s.token.ExpiresAt) > s.refreshBefore {
+		return s.token.AccessToken, nil
+	}
+
+	token, err := s.issuer.ClientCredentialsToken(ctx, s.clientID, s.clientSecret, s.scope)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch service token: %w", err)
+	}
+	s.token = token
+	return token.AccessToken, nil
+}
+
+// Invalidate drops the cached token, e.g. after it was rejected because its
+// signing key was retired early.
+func (s *Source) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = nil
+}
+
+// UnaryClientInterceptor sends the source's token as the bearer token of
+// every call. A call rejected as unauthenticated is retried once with a
+// freshly fetched token.
+func UnaryClientInterceptor(source *Source) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invokeWithToken(ctx, source, method, req, reply, cc, invoker, opts...)
+		if status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+
+		source.Invalidate()
+		return invokeWithToken(ctx, source, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+func invokeWithToken(ctx context.Context, source *Source, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	token, err := source.Token(ctx)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}